@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"fmt"
+	"slices"
 )
 
 // This is the identifier for entities in the world
@@ -19,11 +20,27 @@ type entLoc struct {
 // Provides generic storage for all archetypes
 type archEngine struct {
 	generation int
+	allocation int // The initial capacity of each archetype
+
+	debug     bool // Enables extra checks, see WorldOptions
+	iterating int  // The number of views that are currently being mapped
 
 	lookup      []*lookupList // Indexed by archetypeId
 	compStorage []storage     // Indexed by componentId
 	dcr         *componentRegistry
 
+	sparseStorage []sparseStorage // Every storage that holds a sparse component, so that deletes can clean them up
+
+	holesChanged bool // Set whenever a hole is created, so that automatic compaction can skip its checks when nothing changed
+
+	cursors []*QueryCursor // Cursors that are paused in the middle of a pass, see compactionFloor
+
+	layoutVersion int // Incremented whenever an entity is added, removed or moved, or gains or loses a sparse component. Caches of entity locations use this to tell if they are stale
+
+	reactive         []*ReactiveQuery // Every tracked reactive query, see reactive.go
+	reactiveEvents   []reactiveEvent  // Events waiting to be delivered by flushReactive
+	flushingReactive bool
+
 	// TODO: Optimization: Hook loops can be improved by tracking a slice of CompId for each type of hook. Then when I Track components on that finalizeSlice, I can just loop over the list of CompId which will only be as long as the number of hooks that the user has added
 	onAddHooks    []Handler // A list of hooks to execute for onAdd events. Indexed by componentId
 	finalizeOnAdd []CompId  // The temporary list of components to run the onAdd hooks
@@ -34,11 +51,13 @@ type archEngine struct {
 	// etc...
 }
 
-func newArchEngine() *archEngine {
+func newArchEngine(allocation int) *archEngine {
 	return &archEngine{
-		generation: 1, // Start at 1 so that anyone with the default int value will always realize they are in the wrong generation
+		generation:    1, // Start at 1 so that anyone with the default int value will always realize they are in the wrong generation
+		layoutVersion: 1,
+		allocation:    allocation,
 
-		lookup:      make([]*lookupList, 0, DefaultAllocation),
+		lookup:      make([]*lookupList, 0, allocation),
 		compStorage: make([]storage, maxComponentId+1),
 		dcr:         newComponentRegistry(),
 
@@ -64,19 +83,183 @@ func (e *archEngine) print() {
 func (e *archEngine) newArchetypeId(archMask archetypeMask, components []CompId) archetypeId {
 	e.generation++ // Increment the generation
 
+	// Reuse an archetype that was removed by CollectEmptyArchetypes, if there is one
+	reused := len(e.dcr.free) > 0
 	archId := archetypeId(len(e.lookup))
+	if reused {
+		archId = e.dcr.free[len(e.dcr.free)-1]
+		e.dcr.free = e.dcr.free[:len(e.dcr.free)-1]
+	}
+
+	// Tags don't have any rows, so they are left out of the component list and just get pointed at their shared list
+	tagMask := archMask.bitwiseClear(archMask.withoutTags())
+	if tagMask != blankArchMask {
+		components = archMask.withoutTags().getComponentList()
+		for _, compId := range tagMask.getComponentList() {
+			e.getStorage(compId).(tagStorage).addTagArchetype(archId)
+		}
+	}
+
+	storages := make([]storage, len(components))
+	for i, compId := range components {
+		storages[i] = e.getStorage(compId)
+	}
+
+	if reused {
+		lookup := e.lookup[archId]
+		lookup.mask = archMask
+		lookup.components = components
+		lookup.storages = storages
+		lookup.free = false
+		return archId
+	}
+
 	e.lookup = append(e.lookup,
 		&lookupList{
-			id:         make([]Id, 0, DefaultAllocation),
-			holes:      make([]int, 0, DefaultAllocation),
+			id:         make([]Id, 0, e.allocation),
+			holes:      make([]int, 0, e.allocation),
 			mask:       archMask,
 			components: components,
+			storages:   storages,
 		},
 	)
 
 	return archId
 }
 
+// Removes every archetype that doesn't have any entities, releasing their memory and letting their archetypeIds be reused. Returns the number of archetypes removed
+func (e *archEngine) collectEmptyArchetypes() int {
+	removed := 0
+	for idx, lookup := range e.lookup {
+		if lookup.free || lookup.Len() > 0 {
+			continue
+		}
+		archId := archetypeId(idx)
+
+		for _, compId := range lookup.mask.getComponentList() {
+			if e.compStorage[compId] != nil {
+				e.compStorage[compId].dropArchetype(archId)
+			}
+		}
+		e.dcr.removeArchetype(archId)
+
+		lookup.id = lookup.id[:0]
+		lookup.holes = lookup.holes[:0]
+		lookup.mask = blankArchMask
+		lookup.components = nil
+		lookup.storages = nil
+		lookup.free = true
+		removed++
+	}
+
+	if removed > 0 {
+		e.generation++ // Every filterList needs to drop the removed archetypes
+
+		// Edges might point at the removed archetypes, so they all get rebuilt as they are needed
+		for _, lookup := range e.lookup {
+			lookup.addEdges = lookup.addEdges[:0]
+			lookup.removeEdges = lookup.removeEdges[:0]
+		}
+	}
+	return removed
+}
+
+// Moves every row of the archetype to the end of the archetype of newMask, in one copy per component column.
+// Components that aren't in newMask are dropped and components that are new get the zero value. Holes are moved along with the rows.
+// Note: The caller must update the locations of the moved ids
+// Returns the new archetype and the index of the first moved row in it
+func (e *archEngine) moveTable(from archetypeId, newMask archetypeMask) (archetypeId, int) {
+	to := e.getArchetypeId(newMask)
+	src := e.lookup[from]
+	dst := e.lookup[to]
+
+	e.layoutVersion++
+	start := len(dst.id)
+	for _, hole := range src.holes {
+		dst.holes = append(dst.holes, start+hole)
+	}
+	dst.id = append(dst.id, src.id...)
+	e.notifyMoveTable(src.id, from, to)
+
+	for i, s := range dst.storages {
+		if src.mask.hasComponent(dst.components[i]) {
+			s.moveTable(from, to)
+		} else {
+			s.allocateBatch(to, len(src.id))
+		}
+	}
+	for i, s := range src.storages {
+		if !newMask.hasComponent(src.components[i]) {
+			s.truncate(from)
+		}
+	}
+
+	if len(src.holes) > 0 {
+		e.holesChanged = true
+	}
+	src.id = src.id[:0]
+	src.holes = src.holes[:0]
+	return to, start
+}
+
+// Removes every row of the archetype
+// Note: The caller must remove the ids from the locMap
+func (e *archEngine) truncate(archId archetypeId) {
+	e.notifyExitArchetype(archId)
+	e.layoutVersion++
+	lookup := e.lookup[archId]
+	lookup.id = lookup.id[:0]
+	lookup.holes = lookup.holes[:0]
+	for _, s := range lookup.storages {
+		s.truncate(archId)
+	}
+}
+
+// Makes sure that the archetype has room for n more entities after its last row.
+// Note: Holes aren't counted, because batch spawns always append their rows to the end
+func (e *archEngine) reserve(archId archetypeId, n int) {
+	lookup := e.lookup[archId]
+	lookup.id = slices.Grow(lookup.id, n)
+	for _, store := range lookup.storages {
+		store.reserve(archId, n)
+	}
+}
+
+// Removes every entity, but keeps all of the archetypes and their memory
+func (e *archEngine) reset() {
+	for archId := range e.lookup {
+		e.notifyExitArchetype(archetypeId(archId))
+	}
+	for _, lookup := range e.lookup {
+		lookup.id = lookup.id[:0]
+		lookup.holes = lookup.holes[:0]
+	}
+	for _, ss := range e.compStorage {
+		if ss != nil {
+			ss.clear()
+		}
+	}
+	e.finalizeOnAdd = e.finalizeOnAdd[:0]
+	e.holesChanged = false
+	e.layoutVersion++
+}
+
+// Marks that a view has started mapping, every call must be paired with a call to endIteration
+func (e *archEngine) beginIteration() {
+	e.iterating++
+}
+
+func (e *archEngine) endIteration() {
+	e.iterating--
+}
+
+// If debug checks are enabled, this panics if a view is currently being mapped
+func (e *archEngine) checkNotIterating(op string) {
+	if e.debug && e.iterating > 0 {
+		panic(fmt.Sprintf("ecs: %s can't be called while mapping a view, because it moves entities around", op))
+	}
+}
+
 func (e *archEngine) getGeneration() int {
 	return e.generation
 }
@@ -84,7 +267,7 @@ func (e *archEngine) getGeneration() int {
 func (e *archEngine) count(anything ...any) int {
 	comps := make([]CompId, len(anything))
 	for i, c := range anything {
-		comps[i] = name(c)
+		comps[i] = compIdOfAny(c)
 	}
 
 	archIds := make([]archetypeId, 0)
@@ -117,6 +300,9 @@ func (e *archEngine) FilterList(archIds []archetypeId, comp []CompId) []archetyp
 
 	archIds = archIds[:0]
 	for archId := range e.dcr.revArchMask {
+		if e.lookup[archId].free {
+			continue // Skip: The archetype was removed
+		}
 		if requiredArchMask.contains(e.dcr.revArchMask[archId]) {
 			archIds = append(archIds, archetypeId(archId))
 		}
@@ -151,8 +337,7 @@ func (e *archEngine) FilterList(archIds []archetypeId, comp []CompId) []archetyp
 }
 
 func getStorage[T any](e *archEngine) *componentStorage[T] {
-	var val T
-	n := name(val)
+	n := compIdOf[T]()
 	return getStorageByCompId[T](e, n)
 }
 
@@ -160,10 +345,8 @@ func getStorage[T any](e *archEngine) *componentStorage[T] {
 func getStorageByCompId[T any](e *archEngine, compId CompId) *componentStorage[T] {
 	ss := e.compStorage[compId]
 	if ss == nil {
-		ss = &componentStorage[T]{
-			slice: newMap[archetypeId, *componentList[T]](DefaultAllocation),
-		}
-		e.compStorage[compId] = ss
+		registerComponentStorage[T](compId)
+		ss = e.getStorage(compId)
 	}
 	storage := ss.(*componentStorage[T])
 
@@ -174,6 +357,7 @@ func (e *archEngine) getOrAddLookupIndex(archId archetypeId, id Id) int {
 	lookup := e.lookup[archId]
 
 	index := lookup.addToEasiestHole(id)
+	e.layoutVersion++
 	return index
 }
 
@@ -183,8 +367,10 @@ func (e *archEngine) spawn(archId archetypeId, id Id, comp ...Component) int {
 	lookup := e.lookup[archId]
 	// TODO: Doesn't cleanup holes?
 	index := lookup.addToEasiestHole(id)
+	e.layoutVersion++
 	loc := entLoc{archId, uint32(index)}
 	e.writeIndex(loc, id, comp...)
+	e.notifyEnter(id, archId)
 
 	// All components are added
 	e.finalizeOnAdd = markComponents(e.finalizeOnAdd, comp...)
@@ -196,6 +382,7 @@ func (e *archEngine) writeIndex(loc entLoc, id Id, comp ...Component) {
 	// Loop through all components and add them to individual component slices
 	wd := W{
 		engine: e,
+		id:     id,
 		archId: loc.archId,
 		index:  int(loc.index),
 	}
@@ -211,25 +398,104 @@ func (e *archEngine) allocate(archId archetypeId, id Id) int {
 
 	// for compId registered to archId
 	lookup := e.lookup[archId]
-	for _, compId := range lookup.components {
-		s := e.getStorage(compId)
+	for _, s := range lookup.storages {
 		s.Allocate(archId, index)
 	}
 	return index
 }
 
+// Appends a row for every id to the end of the archetype and returns the index of the first one.
+// Holes are skipped so that the new rows are contiguous and their columns can be written in bulk
+func (e *archEngine) allocateBatch(archId archetypeId, ids []Id) int {
+	lookup := e.lookup[archId]
+	start := len(lookup.id)
+	lookup.id = append(lookup.id, ids...)
+	e.layoutVersion++
+	for _, s := range lookup.storages {
+		s.allocateBatch(archId, len(ids))
+	}
+	for _, id := range ids {
+		e.notifyEnter(id, archId)
+	}
+	return start
+}
+
 func (e *archEngine) getStorage(compId CompId) storage {
 	ss := e.compStorage[compId]
 	if ss == nil {
-		ss = newComponentStorage(compId)
+		ss = newComponentStorage(compId, e.allocation)
 		e.compStorage[compId] = ss
+
+		if isSparse(compId) {
+			e.sparseStorage = append(e.sparseStorage, ss.(sparseStorage))
+		}
 	}
 	return ss
 }
 
-func writeArch[T any](e *archEngine, archId archetypeId, index int, store *componentStorage[T], val T) {
-	cSlice := store.GetSlice(archId)
-	cSlice.Write(index, val)
+func writeArch[T any](e *archEngine, loc entLoc, id Id, store *componentStorage[T], val T) {
+	if store.sparse != nil {
+		if store.sparse.write(id, val) {
+			e.finalizeOnAdd = append(e.finalizeOnAdd, store.compId)
+			e.layoutVersion++
+		}
+		return
+	}
+	if store.tag != nil {
+		return // Tags don't have any data to write
+	}
+
+	cSlice := store.GetSlice(loc.archId)
+	cSlice.Write(int(loc.index), val)
+}
+
+// Writes the same value to every row of a batch that was allocated with allocateBatch. Unlike writeArch this doesn't mark any hooks, the caller is expected to run them for the whole batch
+func writeArchBatch[T any](e *archEngine, archId archetypeId, start int, ids []Id, store *componentStorage[T], val T) {
+	if store.sparse != nil {
+		for _, id := range ids {
+			store.sparse.write(id, val)
+		}
+		e.layoutVersion++
+		return
+	}
+	if store.tag != nil {
+		return // Tags don't have any data to write
+	}
+
+	column := store.GetSlice(archId).comp[start : start+len(ids)]
+	for i := range column {
+		column[i] = val
+	}
+}
+
+// Removes the entity from every sparse storage, returns true if it had any sparse components
+func (e *archEngine) deleteSparse(id Id) bool {
+	removed := false
+	for _, ss := range e.sparseStorage {
+		if ss.removeSparse(id) {
+			removed = true
+		}
+	}
+	if removed {
+		e.layoutVersion++
+	}
+	return removed
+}
+
+// Returns true if the entity has the sparse component
+func (e *archEngine) hasSparse(compId CompId, id Id) bool {
+	ss, ok := e.compStorage[compId].(sparseStorage)
+	return ok && ss.hasSparse(id)
+}
+
+// Returns true if the entity has any sparse components
+func (e *archEngine) hasAnySparse(id Id) bool {
+	for _, ss := range e.sparseStorage {
+		if ss.hasSparse(id) {
+			return true
+		}
+	}
+	return false
 }
 
 // Returns the archetypeId of where the entity ends up
@@ -259,37 +525,31 @@ func (e *archEngine) rewriteArch(loc entLoc, id Id, comp ...Component) entLoc {
 	}
 }
 
-// Moves an entity from one archetype to another, copying all of the data from the old archetype to the new one
+// Moves an entity from one archetype to another, copying all of the components that both archetypes have
 func (e *archEngine) moveArchetype(oldLoc entLoc, newMask archetypeMask, id Id) entLoc {
-	newArchId := e.dcr.getArchetypeId(e, newMask)
-	newIndex := e.allocate(newArchId, id)
-	newLoc := entLoc{newArchId, uint32(newIndex)}
-
-	oldLookup := e.lookup[oldLoc.archId]
-
-	for _, compId := range oldLookup.components {
-		store := e.compStorage[compId]
-		store.moveArchetype(oldLoc, newLoc)
+	edge, cached := e.getEdge(oldLoc.archId, newMask)
+	if !cached {
+		edge.archId = e.getArchetypeId(newMask)
 	}
 
-	e.TagForDeletion(oldLoc, id)
-
-	return entLoc{newArchId, uint32(newIndex)}
-}
-
-// Moves an entity from one archetype to another, copying all of the data required by the new archetype
-func (e *archEngine) moveArchetypeDown(oldLoc entLoc, newMask archetypeMask, id Id) entLoc {
-	newArchId := e.dcr.getArchetypeId(e, newMask)
-	newIndex := e.allocate(newArchId, id)
+	newIndex := e.allocate(edge.archId, id)
+	newLoc := entLoc{edge.archId, uint32(newIndex)}
 
-	newLoc := entLoc{newArchId, uint32(newIndex)}
-	newLookup := e.lookup[newArchId]
-	for _, compId := range newLookup.components {
-		store := e.compStorage[compId]
-		store.moveArchetype(oldLoc, newLoc) //oldArchId, oldIndex, newArchId, newIndex)
+	if cached {
+		for _, store := range edge.move {
+			store.moveArchetype(oldLoc, newLoc)
+		}
+	} else {
+		oldLookup := e.lookup[oldLoc.archId]
+		for _, compId := range oldLookup.components {
+			if newMask.hasComponent(compId) {
+				e.compStorage[compId].moveArchetype(oldLoc, newLoc)
+			}
+		}
 	}
 
 	e.TagForDeletion(oldLoc, id)
+	e.notifyMove(id, oldLoc.archId, newLoc.archId)
 
 	return newLoc
 }
@@ -308,6 +568,8 @@ func (e *archEngine) TagForDeletion(loc entLoc, id Id) {
 
 	// This is used to track the current list of indices that need to be cleaned
 	lookup.holes = append(lookup.holes, int(loc.index))
+	e.holesChanged = true
+	e.layoutVersion++
 }
 
 // func (e *archEngine) CleanupHoles(archId archetypeId) {
@@ -369,61 +631,75 @@ func (e *archEngine) TagForDeletion(loc entLoc, id Id) {
 
 // This is a defragment operation which tries to repack entities closer together
 // You wont usually need to do this, but if you delete a lot of entities of one archetype and dont plan
-// to add them back, then you can run this to repack
+// to add them back, then you can run this to repack. See SetCompactionPolicy to do this automatically
 func (w *World) CleanupHoles() {
-	for lookupIdx, lookup := range w.engine.lookup {
-		archId := archetypeId(lookupIdx)
-
-		for _, index := range lookup.holes {
-			// Pop all holes off the end of the archetype
-			for {
-				lastIndex := len(lookup.id) - 1
-				if lastIndex < 0 {
-					break // Break if the index we are trying to pop off is -1
-				}
-				lastId := lookup.id[lastIndex]
-				if lastId == InvalidEntity {
-					// If the last id is a hole, then slice it off
-					lookup.id = lookup.id[:lastIndex]
-					for n := range w.engine.compStorage {
-						if w.engine.compStorage[n] != nil {
-							w.engine.compStorage[n].Delete(archId, lastIndex)
-						}
-					}
-
-					continue // Try again
-				}
-
-				break
-			}
+	w.engine.checkNotIterating("CleanupHoles")
+	for lookupIdx := range w.engine.lookup {
+		w.cleanupArchetype(archetypeId(lookupIdx))
+	}
+}
 
-			// Check bounds because we may have popped past our original index
-			if index >= len(lookup.id) {
-				continue
-			}
+// Repacks a single archetype by moving entities from the end of the archetype into its holes
+func (w *World) cleanupArchetype(archId archetypeId) {
+	lookup := w.engine.lookup[archId]
+	if len(lookup.holes) > 0 {
+		w.engine.layoutVersion++
+	}
 
-			// Swap lastIndex (which is not a hole) with index (which is a hole)
+	// Note: Holes that a paused cursor has already passed are kept. Kept holes are written back into the front of the same slice, which is safe because it never overtakes the loop
+	floor := w.engine.compactionFloor(archId)
+	kept := lookup.holes[:0]
+	for _, index := range lookup.holes {
+		// Pop all holes off the end of the archetype
+		for {
 			lastIndex := len(lookup.id) - 1
+			if lastIndex < 0 {
+				break // Break if the index we are trying to pop off is -1
+			}
 			lastId := lookup.id[lastIndex]
 			if lastId == InvalidEntity {
-				panic("Bug: This shouldn't happen")
+				// If the last id is a hole, then slice it off
+				lookup.id = lookup.id[:lastIndex]
+				for _, store := range lookup.storages {
+					store.Delete(archId, lastIndex)
+				}
+
+				continue // Try again
 			}
 
-			// Update id list
-			lookup.id[index] = lastId
-			lookup.id = lookup.id[:lastIndex]
+			break
+		}
 
-			// Update entity location for this id
-			newEntLoc := entLoc{archId, uint32(index)} // lookup.index.Put(lastId, index)
-			w.arch.Put(lastId, newEntLoc)
-			for n := range w.engine.compStorage {
-				if w.engine.compStorage[n] != nil {
-					w.engine.compStorage[n].Delete(archId, index)
-				}
-			}
+		// Check bounds because we may have popped past our original index
+		if index >= len(lookup.id) {
+			continue
+		}
+		if index < floor {
+			kept = append(kept, index)
+			continue
 		}
 
-		// Clear holes slice
-		lookup.holes = lookup.holes[:0]
+		// Swap lastIndex (which is not a hole) with index (which is a hole)
+		lastIndex := len(lookup.id) - 1
+		lastId := lookup.id[lastIndex]
+		if lastId == InvalidEntity {
+			panic("Bug: This shouldn't happen")
+		}
+
+		// Update id list
+		lookup.id[index] = lastId
+		lookup.id = lookup.id[:lastIndex]
+
+		// Update entity location for this id
+		newEntLoc := entLoc{archId, uint32(index)} // lookup.index.Put(lastId, index)
+		w.arch.Put(lastId, newEntLoc)
+		for _, store := range lookup.storages {
+			store.Delete(archId, index)
+		}
 	}
+
+	// Popping holes off the end might have removed some of the kept holes too
+	lookup.holes = slices.DeleteFunc(kept, func(index int) bool {
+		return index >= len(lookup.id)
+	})
 }
@@ -442,51 +442,51 @@ func BenchmarkAllocateManual(b *testing.B) {
 	}
 }
 
-// func BenchmarkAllocateBundle4(b *testing.B) {
-// 	world := NewWorld()
+func BenchmarkAllocateBundle4(b *testing.B) {
+	world := NewWorld()
 
-// 	var myBundle2 = NewBundle4[position, velocity, acceleration, radius]()
+	var myBundle2 = NewBundle4[position, velocity, acceleration, radius]()
 
-// 	bun := &Bundler{}
+	bun := &Bundler{}
 
-// 	b.ResetTimer()
+	b.ResetTimer()
 
-// 	for n := 0; n < b.N; n++ {
-// 		for i := 0; i < addEntSize; i++ {
-// 			bun.Clear()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < addEntSize; i++ {
+			bun.Clear()
 
-// 			myBundle2.Unbundle(bun,
-// 				position{1, 2, 3},
-// 				velocity{1, 2, 3},
-// 				acceleration{1, 2, 3},
-// 				radius{1},
-// 			)
+			myBundle2.Unbundle(bun,
+				position{1, 2, 3},
+				velocity{1, 2, 3},
+				acceleration{1, 2, 3},
+				radius{1},
+			)
 
-// 			id := world.NewId()
-// 			bun.Write(world, id)
-// 		}
-// 	}
-// }
+			id := world.NewId()
+			bun.Write(world, id)
+		}
+	}
+}
 
-// func BenchmarkAllocateBundle4Direct(b *testing.B) {
-// 	world := NewWorld()
+func BenchmarkAllocateBundle4Direct(b *testing.B) {
+	world := NewWorld()
 
-// 	var myBundle2 = NewBundle4[position, velocity, acceleration, radius]()
+	var myBundle2 = NewBundle4[position, velocity, acceleration, radius]()
 
-// 	b.ResetTimer()
+	b.ResetTimer()
 
-// 	for n := 0; n < b.N; n++ {
-// 		for i := 0; i < addEntSize; i++ {
-// 			id := world.NewId()
-// 			myBundle2.Write(world, id,
-// 				position{1, 2, 3},
-// 				velocity{1, 2, 3},
-// 				acceleration{1, 2, 3},
-// 				radius{1},
-// 			)
-// 		}
-// 	}
-// }
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < addEntSize; i++ {
+			id := world.NewId()
+			myBundle2.Write(world, id,
+				position{1, 2, 3},
+				velocity{1, 2, 3},
+				acceleration{1, 2, 3},
+				radius{1},
+			)
+		}
+	}
+}
 
 func BenchmarkAllocateNonBundle4Direct(b *testing.B) {
 	world := NewWorld()
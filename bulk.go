@@ -0,0 +1,226 @@
+package ecs
+
+import "slices"
+
+// Implemented by every view, so that they can be used to select the entities of bulk operations
+type AnyView interface {
+	viewFilter() (*World, *filterList)
+}
+
+// Adds the components to every entity that matches the view, and returns the number of matching entities.
+// When every entity in an archetype matches, the whole archetype is moved at once instead of moving the entities one by one.
+// This moves entities between archetypes, so it can't be called while mapping a view
+func AddToMatching(view AnyView, comp ...Component) int {
+	world, filter := view.viewFilter()
+	world.engine.checkNotIterating("AddToMatching")
+	if len(comp) <= 0 {
+		return 0
+	}
+
+	if filter.hasSparse() || hasSparseComponent(comp) {
+		return forEachMatchingId(world, filter, func(id Id) {
+			world.Write(id, comp...)
+		})
+	}
+
+	addMask := buildArchMask(comp...)
+	total := 0
+	forEachMatchingArchetype(world, filter, func(archId archetypeId, rows int) {
+		lookup := world.engine.lookup[archId]
+		oldMask := lookup.mask
+		newMask := oldMask.bitwiseOr(addMask)
+
+		if newMask == oldMask {
+			// Nothing moves, so we just overwrite the components in place
+			total += forEachMatchingRow(world, filter, archId, rows, func(id Id, index int) {
+				world.engine.writeIndex(entLoc{archId, uint32(index)}, id, comp...)
+			})
+			return
+		}
+
+		// Every row qualifies, so the whole table can be moved at once
+		to, start := world.moveTable(archId, newMask)
+		moved := world.engine.lookup[to].id[start:]
+		ids := make([]Id, 0, len(moved))
+		wd := W{
+			engine: world.engine,
+			archId: to,
+		}
+		for i, id := range moved {
+			if id == InvalidEntity {
+				continue
+			}
+			wd.id = id
+			wd.index = start + i
+			for j := range comp {
+				comp[j].CompWrite(wd)
+			}
+			ids = append(ids, id)
+		}
+
+		// Hooks are run after every entity has been written, so that they see a consistent world
+		world.engine.runBatchHooks(ids, newMask.bitwiseClear(oldMask))
+		total += len(ids)
+	})
+	return total
+}
+
+// Removes the components from every entity that matches the view, and returns the number of matching entities.
+// Like DeleteComponent, entities that don't have any components left are deleted.
+// When every entity in an archetype matches, the whole archetype is moved at once instead of moving the entities one by one.
+// This moves entities between archetypes, so it can't be called while mapping a view
+func RemoveFromMatching(view AnyView, comp ...Component) int {
+	world, filter := view.viewFilter()
+	world.engine.checkNotIterating("RemoveFromMatching")
+	if len(comp) <= 0 {
+		return 0
+	}
+
+	// Note: Removing every component deletes the entity, unless it still has sparse components, so that has to be checked one by one
+	removeMask := buildArchMask(comp...)
+	if filter.hasSparse() || hasSparseComponent(comp) || removesEverything(world, filter, removeMask) {
+		total := forEachMatchingId(world, filter, func(id Id) {
+			DeleteComponent(world, id, comp...)
+		})
+		world.engine.flushReactive()
+		return total
+	}
+
+	total := 0
+	forEachMatchingArchetype(world, filter, func(archId archetypeId, rows int) {
+		lookup := world.engine.lookup[archId]
+		oldMask := lookup.mask
+		newMask := oldMask.bitwiseClear(removeMask)
+
+		if newMask == oldMask {
+			total += forEachMatchingRow(world, filter, archId, rows, func(id Id, index int) {})
+			return
+		}
+
+		total += lookup.Len()
+		world.moveTable(archId, newMask)
+	})
+	world.engine.flushReactive()
+	return total
+}
+
+// Deletes every entity that matches the view, and returns the number of entities deleted.
+// When every entity in an archetype matches, the whole archetype is cleared at once.
+// This can't be called while mapping a view
+func DeleteMatching(view AnyView) int {
+	world, filter := view.viewFilter()
+	world.engine.checkNotIterating("DeleteMatching")
+
+	total := 0
+	forEachMatchingArchetype(world, filter, func(archId archetypeId, rows int) {
+		if filter.hasSparse() {
+			total += forEachMatchingRow(world, filter, archId, rows, func(id Id, index int) {
+				Delete(world, id)
+			})
+			return
+		}
+
+		total += forEachMatchingRow(world, filter, archId, rows, func(id Id, index int) {
+			world.arch.Delete(id)
+			world.engine.deleteSparse(id)
+		})
+		world.engine.truncate(archId)
+	})
+	world.engine.flushReactive()
+	return total
+}
+
+// Moves the whole archetype to the archetype of newMask and updates the locations of every moved entity.
+// Returns the new archetype and the index of the first moved row. Holes are moved along with the rows, so they need to be skipped by the caller
+func (world *World) moveTable(archId archetypeId, newMask archetypeMask) (archetypeId, int) {
+	to, start := world.engine.moveTable(archId, newMask)
+	for i, id := range world.engine.lookup[to].id[start:] {
+		if id == InvalidEntity {
+			continue
+		}
+		world.arch.Put(id, entLoc{to, uint32(start + i)})
+	}
+	return to, start
+}
+
+// Calls the lambda for every archetype that matches the filter, along with the number of rows it had before any archetype was changed.
+// The list of archetypes is copied first, because moving entities can create new archetypes
+func forEachMatchingArchetype(world *World, filter *filterList, lambda func(archId archetypeId, rows int)) {
+	filter.regenerate(world)
+	archIds := slices.Clone(filter.archIds)
+	rows := make([]int, len(archIds))
+	for i, archId := range archIds {
+		rows[i] = len(world.engine.lookup[archId].id)
+	}
+
+	for i, archId := range archIds {
+		lookup := world.engine.lookup[archId]
+		if lookup.Len() == 0 {
+			continue
+		}
+		lambda(archId, rows[i])
+	}
+}
+
+// Calls the lambda for every entity that matches the filter, and returns the number of entities it was called for.
+// Moving an entity one at a time can fill a hole in an archetype that hasn't been visited yet, so the ids are all collected before the lambda is called for any of them.
+// Entities that are deleted by an earlier call are skipped
+func forEachMatchingId(world *World, filter *filterList, lambda func(id Id)) int {
+	var ids []Id
+	forEachMatchingArchetype(world, filter, func(archId archetypeId, rows int) {
+		forEachMatchingRow(world, filter, archId, rows, func(id Id, index int) {
+			ids = append(ids, id)
+		})
+	})
+
+	total := 0
+	for _, id := range ids {
+		if !world.Exists(id) {
+			continue
+		}
+		lambda(id)
+		total++
+	}
+	return total
+}
+
+// Calls the lambda for every entity in the first rows of the archetype that matches the filter, and returns the number of entities it was called for.
+// Rows past that were moved in by the current bulk operation, so they are skipped
+func forEachMatchingRow(world *World, filter *filterList, archId archetypeId, rows int, lambda func(id Id, index int)) int {
+	lookup := world.engine.lookup[archId]
+	sparse := filter.hasSparse()
+
+	total := 0
+	for index := 0; index < rows; index++ {
+		id := lookup.id[index]
+		if id == InvalidEntity {
+			continue
+		}
+		if sparse && !filter.matchesSparse(world.engine, id) {
+			continue
+		}
+		lambda(id, index)
+		total++
+	}
+	return total
+}
+
+// Returns true if removing the mask leaves any of the matching archetypes without components
+func removesEverything(world *World, filter *filterList, removeMask archetypeMask) bool {
+	filter.regenerate(world)
+	for _, archId := range filter.archIds {
+		if world.engine.lookup[archId].mask.bitwiseClear(removeMask) == blankArchMask {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSparseComponent(comp []Component) bool {
+	for _, c := range comp {
+		if isSparse(c.CompId()) {
+			return true
+		}
+	}
+	return false
+}
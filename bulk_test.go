@@ -0,0 +1,125 @@
+package ecs
+
+import "testing"
+
+func TestAddToMatching(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}))
+	b := world.Spawn(C(position{2, 2, 2}), C(radius{2}))
+	c := world.Spawn(C(position{3, 3, 3}), C(velocity{}))
+	Delete(world, world.Spawn(C(position{}))) // Leave a hole behind that gets moved too
+	skipped := world.Spawn(C(position{4, 4, 4}), C(enemy{}))
+
+	added := 0
+	world.SetHookOnAdd(C(velocity{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		added++
+	}))
+
+	query := Query1[position](world, Without(enemy{}))
+	compare(t, AddToMatching(query, C(velocity{5, 5, 5})), 3)
+	compare(t, added, 2) // c already had a velocity
+
+	for _, id := range []Id{a, b, c} {
+		v, ok := Read[velocity](world, id)
+		check(t, ok)
+		compare(t, v, velocity{5, 5, 5})
+	}
+	p, _ := Read[position](world, b)
+	compare(t, p, position{2, 2, 2})
+	r, _ := Read[radius](world, b)
+	compare(t, r, radius{2})
+	check(t, !world.hasCompId(skipped, velocity{}.CompId()))
+	compare(t, Query2[position, velocity](world).Count(), 3)
+
+	// Moved entities can still be moved and deleted one by one
+	DeleteComponent(world, a, C(velocity{}))
+	check(t, Delete(world, b))
+	compare(t, Query2[position, velocity](world).Count(), 1)
+}
+
+// Entities that are moved one at a time can fill holes in archetypes that haven't been visited yet, they must still only be handled once
+func TestBulkPerEntityWithHoles(t *testing.T) {
+	world := NewWorld()
+	SpawnBatch(world, 3, C(position{}))
+	Delete(world, world.Spawn(C(position{}), C(velocity{}))) // Leave a hole in the archetype that they move to
+
+	written := 0
+	world.SetHookOnAdd(C(stunned{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		written++
+	}))
+	compare(t, AddToMatching(Query1[position](world), C(velocity{}), C(stunned{})), 3)
+	compare(t, written, 3)
+	compare(t, Query2[position, velocity](world).Count(), 3)
+
+	Delete(world, world.Spawn(C(position{}))) // Leave a hole in the archetype that they move back to
+	compare(t, RemoveFromMatching(Query1[position](world, With(stunned{})), C(velocity{})), 3)
+	compare(t, Query1[position](world, Without(velocity{})).Count(), 3)
+
+	// Removing every component from one of the archetypes
+	a := world.Spawn(C(velocity{}))
+	Delete(world, world.Spawn(C(position{}), C(velocity{})))
+	compare(t, RemoveFromMatching(Query1[velocity](world), C(velocity{})), 1)
+	check(t, !world.Exists(a))
+}
+
+func TestRemoveFromMatching(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), C(velocity{1, 1, 1}))
+	b := world.Spawn(C(position{2, 2, 2}), C(velocity{2, 2, 2}), C(radius{2}))
+	c := world.Spawn(C(velocity{3, 3, 3}))
+	d := world.Spawn(C(velocity{4, 4, 4}), C(stunned{4}))
+
+	query := Query1[velocity](world)
+	compare(t, RemoveFromMatching(query, C(velocity{})), 4)
+	compare(t, query.Count(), 0)
+
+	p, ok := Read[position](world, a)
+	check(t, ok)
+	compare(t, p, position{1, 1, 1})
+	r, ok := Read[radius](world, b)
+	check(t, ok)
+	compare(t, r, radius{2})
+
+	// Entities without any components left are deleted, unless they still have sparse components
+	check(t, !world.Exists(c))
+	check(t, world.Exists(d))
+}
+
+func TestDeleteMatching(t *testing.T) {
+	world := NewWorld()
+	ids := SpawnBatch(world, 10, C(position{}), C(velocity{}))
+	keep := world.Spawn(C(position{}))
+	stunnedId := world.Spawn(C(position{}), C(stunned{}))
+
+	compare(t, DeleteMatching(Query1[position](world, Without(stunned{}))), 11)
+	for _, id := range ids {
+		check(t, !world.Exists(id))
+	}
+	check(t, !world.Exists(keep))
+	check(t, world.Exists(stunnedId))
+
+	compare(t, DeleteMatching(Query1[stunned](world)), 1)
+	check(t, !world.Exists(stunnedId))
+	compare(t, Query1[position](world).Count(), 0)
+
+	// The archetypes can be reused afterwards
+	id := world.Spawn(C(position{1, 2, 3}), C(velocity{}))
+	p, ok := Read[position](world, id)
+	check(t, ok)
+	compare(t, p, position{1, 2, 3})
+}
+
+func TestAddToMatchingDynamic(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{}), dynRaw.With([]byte{1, 2, 3}))
+	b := world.Spawn(C(position{}), dynRaw.With([]byte{4, 5, 6}))
+
+	compare(t, AddToMatching(QueryDynamic(world, []CompId{dynRaw.CompId()}), C(velocity{})), 2)
+
+	raw, ok := ReadDynamic(world, a, dynRaw)
+	check(t, ok)
+	compare(t, string(raw), string([]byte{1, 2, 3}))
+	raw, ok = ReadDynamic(world, b, dynRaw)
+	check(t, ok)
+	compare(t, string(raw), string([]byte{4, 5, 6}))
+}
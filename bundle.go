@@ -1,20 +1,28 @@
 package ecs
 
 type Bundler struct {
-	archMask archetypeMask // The current archetypeMask
-	// TODO: Instead of set, you could just use the arch mask
-	Set                 [maxComponentId]bool      // The list of components that are being bundled
-	Components          [maxComponentId]Component // Component storage array for everything we've bundled
-	maxComponentIdAdded CompId
+	archMask archetypeMask // The current archetypeMask, this is also the set of components that are being bundled
+
+	// Component storage for everything we've bundled. Boxes are kept between clears so they can be reused without allocating.
+	// Only the components set in the archMask are valid
+	Components []Component
 }
 
 func (b *Bundler) Clear() {
 	b.archMask = blankArchMask
-	b.Set = [maxComponentId]bool{}
-	b.maxComponentIdAdded = 0
 	// b.Components // Note: No need to clear because we only use set values
 }
 
+// Returns the index of the component box with the supplied compId, or -1 if there isn't one
+func (b *Bundler) findIndex(compId CompId) int {
+	for i := range b.Components {
+		if b.Components[i].CompId() == compId {
+			return i
+		}
+	}
+	return -1
+}
+
 // func (bun *Bundler) Add(comp Component) {
 // 	compId := comp.id()
 // 	bun.archMask.addComponent(compId)
@@ -29,17 +37,17 @@ func (b *Bundler) Clear() {
 // }
 
 func (bun *Bundler) Has(comp Component) bool {
-	return bun.Set[comp.CompId()]
+	return bun.archMask.hasComponent(comp.CompId())
 }
 
 func readBundle[T Component](bun *Bundler) (T, bool) {
 	var comp T
 	compId := comp.CompId()
 
-	if !bun.Set[compId] {
+	if !bun.archMask.hasComponent(compId) {
 		return comp, false // Was not set
 	}
-	return bun.Components[compId].(*box[T]).val, true
+	return bun.Components[bun.findIndex(compId)].(*box[T]).val, true
 }
 
 // func (bun *Bundler) Read(comp Component) (Component, bool) {
@@ -52,7 +60,6 @@ func readBundle[T Component](bun *Bundler) (T, bool) {
 
 func (bun *Bundler) Remove(compId CompId) {
 	bun.archMask.removeComponent(compId)
-	bun.Set[compId] = false
 }
 
 // func WriteComponent[T any](bun *Bundler, comp T) {
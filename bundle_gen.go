@@ -0,0 +1,1359 @@
+package ecs
+
+// Warning: This is an autogenerated file. Do not modify!!
+
+// --------------------------------------------------------------------------------
+// - Bundle 1
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle1[A any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle1[A any]() Bundle1[A] {
+
+	compA := NewComp[A]()
+
+	return Bundle1[A]{
+		mask: buildArchMaskFromId(compA.CompId()),
+
+		compA: compA,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle1[A]) Write(world *World, id Id, a A) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle1[A]) Spawn(world *World, a A) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle1[A]) SpawnBatch(world *World, n int, a A) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle1[A]) Unbundle(bun *Bundler, a A) {
+
+	bundle.compA.UnbundleVal(bun, a)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 2
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle2[A, B any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle2[A, B any]() Bundle2[A, B] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+
+	return Bundle2[A, B]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId()),
+
+		compA: compA,
+		compB: compB,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle2[A, B]) Write(world *World, id Id, a A, b B) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle2[A, B]) Spawn(world *World, a A, b B) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle2[A, B]) SpawnBatch(world *World, n int, a A, b B) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle2[A, B]) Unbundle(bun *Bundler, a A, b B) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 3
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle3[A, B, C any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle3[A, B, C any]() Bundle3[A, B, C] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+
+	return Bundle3[A, B, C]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle3[A, B, C]) Write(world *World, id Id, a A, b B, c C) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle3[A, B, C]) Spawn(world *World, a A, b B, c C) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle3[A, B, C]) SpawnBatch(world *World, n int, a A, b B, c C) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle3[A, B, C]) Unbundle(bun *Bundler, a A, b B, c C) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 4
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle4[A, B, C, D any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle4[A, B, C, D any]() Bundle4[A, B, C, D] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+
+	return Bundle4[A, B, C, D]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle4[A, B, C, D]) Write(world *World, id Id, a A, b B, c C, d D) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle4[A, B, C, D]) Spawn(world *World, a A, b B, c C, d D) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle4[A, B, C, D]) SpawnBatch(world *World, n int, a A, b B, c C, d D) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle4[A, B, C, D]) Unbundle(bun *Bundler, a A, b B, c C, d D) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 5
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle5[A, B, C, D, E any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle5[A, B, C, D, E any]() Bundle5[A, B, C, D, E] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+
+	return Bundle5[A, B, C, D, E]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle5[A, B, C, D, E]) Write(world *World, id Id, a A, b B, c C, d D, e E) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle5[A, B, C, D, E]) Spawn(world *World, a A, b B, c C, d D, e E) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle5[A, B, C, D, E]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle5[A, B, C, D, E]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 6
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle6[A, B, C, D, E, F any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle6[A, B, C, D, E, F any]() Bundle6[A, B, C, D, E, F] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+
+	return Bundle6[A, B, C, D, E, F]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle6[A, B, C, D, E, F]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle6[A, B, C, D, E, F]) Spawn(world *World, a A, b B, c C, d D, e E, f F) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle6[A, B, C, D, E, F]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle6[A, B, C, D, E, F]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 7
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle7[A, B, C, D, E, F, G any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+	compG comp[G]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle7[A, B, C, D, E, F, G any]() Bundle7[A, B, C, D, E, F, G] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+	compG := NewComp[G]()
+
+	return Bundle7[A, B, C, D, E, F, G]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId(), compG.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+		compG: compG,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle7[A, B, C, D, E, F, G]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F, g G) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+	writeArch(world.engine, loc, id, storageG, g)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle7[A, B, C, D, E, F, G]) Spawn(world *World, a A, b B, c C, d D, e E, f F, g G) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f, g)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle7[A, B, C, D, E, F, G]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F, g G) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageG, g)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle7[A, B, C, D, E, F, G]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F, g G) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+	bundle.compG.UnbundleVal(bun, g)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 8
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle8[A, B, C, D, E, F, G, H any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+	compG comp[G]
+	compH comp[H]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle8[A, B, C, D, E, F, G, H any]() Bundle8[A, B, C, D, E, F, G, H] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+	compG := NewComp[G]()
+	compH := NewComp[H]()
+
+	return Bundle8[A, B, C, D, E, F, G, H]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId(), compG.CompId(), compH.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+		compG: compG,
+		compH: compH,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle8[A, B, C, D, E, F, G, H]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F, g G, h H) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+	writeArch(world.engine, loc, id, storageG, g)
+	writeArch(world.engine, loc, id, storageH, h)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle8[A, B, C, D, E, F, G, H]) Spawn(world *World, a A, b B, c C, d D, e E, f F, g G, h H) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f, g, h)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle8[A, B, C, D, E, F, G, H]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F, g G, h H) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageG, g)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageH, h)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle8[A, B, C, D, E, F, G, H]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F, g G, h H) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+	bundle.compG.UnbundleVal(bun, g)
+	bundle.compH.UnbundleVal(bun, h)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 9
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle9[A, B, C, D, E, F, G, H, I any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+	compG comp[G]
+	compH comp[H]
+	compI comp[I]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle9[A, B, C, D, E, F, G, H, I any]() Bundle9[A, B, C, D, E, F, G, H, I] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+	compG := NewComp[G]()
+	compH := NewComp[H]()
+	compI := NewComp[I]()
+
+	return Bundle9[A, B, C, D, E, F, G, H, I]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId(), compG.CompId(), compH.CompId(), compI.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+		compG: compG,
+		compH: compH,
+		compI: compI,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle9[A, B, C, D, E, F, G, H, I]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F, g G, h H, i I) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+	writeArch(world.engine, loc, id, storageG, g)
+	writeArch(world.engine, loc, id, storageH, h)
+	writeArch(world.engine, loc, id, storageI, i)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle9[A, B, C, D, E, F, G, H, I]) Spawn(world *World, a A, b B, c C, d D, e E, f F, g G, h H, i I) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f, g, h, i)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle9[A, B, C, D, E, F, G, H, I]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F, g G, h H, i I) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageG, g)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageH, h)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageI, i)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle9[A, B, C, D, E, F, G, H, I]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F, g G, h H, i I) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+	bundle.compG.UnbundleVal(bun, g)
+	bundle.compH.UnbundleVal(bun, h)
+	bundle.compI.UnbundleVal(bun, i)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 10
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle10[A, B, C, D, E, F, G, H, I, J any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+	compG comp[G]
+	compH comp[H]
+	compI comp[I]
+	compJ comp[J]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle10[A, B, C, D, E, F, G, H, I, J any]() Bundle10[A, B, C, D, E, F, G, H, I, J] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+	compG := NewComp[G]()
+	compH := NewComp[H]()
+	compI := NewComp[I]()
+	compJ := NewComp[J]()
+
+	return Bundle10[A, B, C, D, E, F, G, H, I, J]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId(), compG.CompId(), compH.CompId(), compI.CompId(), compJ.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+		compG: compG,
+		compH: compH,
+		compI: compI,
+		compJ: compJ,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle10[A, B, C, D, E, F, G, H, I, J]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+	storageJ := getStorageByCompId[J](world.engine, bundle.compJ.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+	writeArch(world.engine, loc, id, storageG, g)
+	writeArch(world.engine, loc, id, storageH, h)
+	writeArch(world.engine, loc, id, storageI, i)
+	writeArch(world.engine, loc, id, storageJ, j)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle10[A, B, C, D, E, F, G, H, I, J]) Spawn(world *World, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f, g, h, i, j)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle10[A, B, C, D, E, F, G, H, I, J]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+	storageJ := getStorageByCompId[J](world.engine, bundle.compJ.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageG, g)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageH, h)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageI, i)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageJ, j)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle10[A, B, C, D, E, F, G, H, I, J]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+	bundle.compG.UnbundleVal(bun, g)
+	bundle.compH.UnbundleVal(bun, h)
+	bundle.compI.UnbundleVal(bun, i)
+	bundle.compJ.UnbundleVal(bun, j)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 11
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle11[A, B, C, D, E, F, G, H, I, J, K any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+	compG comp[G]
+	compH comp[H]
+	compI comp[I]
+	compJ comp[J]
+	compK comp[K]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle11[A, B, C, D, E, F, G, H, I, J, K any]() Bundle11[A, B, C, D, E, F, G, H, I, J, K] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+	compG := NewComp[G]()
+	compH := NewComp[H]()
+	compI := NewComp[I]()
+	compJ := NewComp[J]()
+	compK := NewComp[K]()
+
+	return Bundle11[A, B, C, D, E, F, G, H, I, J, K]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId(), compG.CompId(), compH.CompId(), compI.CompId(), compJ.CompId(), compK.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+		compG: compG,
+		compH: compH,
+		compI: compI,
+		compJ: compJ,
+		compK: compK,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle11[A, B, C, D, E, F, G, H, I, J, K]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+	storageJ := getStorageByCompId[J](world.engine, bundle.compJ.compId)
+	storageK := getStorageByCompId[K](world.engine, bundle.compK.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+	writeArch(world.engine, loc, id, storageG, g)
+	writeArch(world.engine, loc, id, storageH, h)
+	writeArch(world.engine, loc, id, storageI, i)
+	writeArch(world.engine, loc, id, storageJ, j)
+	writeArch(world.engine, loc, id, storageK, k)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle11[A, B, C, D, E, F, G, H, I, J, K]) Spawn(world *World, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f, g, h, i, j, k)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle11[A, B, C, D, E, F, G, H, I, J, K]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+	storageJ := getStorageByCompId[J](world.engine, bundle.compJ.compId)
+	storageK := getStorageByCompId[K](world.engine, bundle.compK.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageG, g)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageH, h)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageI, i)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageJ, j)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageK, k)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle11[A, B, C, D, E, F, G, H, I, J, K]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+	bundle.compG.UnbundleVal(bun, g)
+	bundle.compH.UnbundleVal(bun, h)
+	bundle.compI.UnbundleVal(bun, i)
+	bundle.compJ.UnbundleVal(bun, j)
+	bundle.compK.UnbundleVal(bun, k)
+}
+
+// --------------------------------------------------------------------------------
+// - Bundle 12
+// --------------------------------------------------------------------------------
+
+// Represents a fixed set of component types that can be written to an entity without boxing every value with ecs.C(...).
+// The archetype mask is calculated once when the bundle is created, so writes don't need to look up any component ids.
+type Bundle12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
+	mask archetypeMask
+
+	compA comp[A]
+	compB comp[B]
+	compC comp[C]
+	compD comp[D]
+	compE comp[E]
+	compF comp[F]
+	compG comp[G]
+	compH comp[H]
+	compI comp[I]
+	compJ comp[J]
+	compK comp[K]
+	compL comp[L]
+}
+
+// Creates a bundle for the specified component types
+func NewBundle12[A, B, C, D, E, F, G, H, I, J, K, L any]() Bundle12[A, B, C, D, E, F, G, H, I, J, K, L] {
+
+	compA := NewComp[A]()
+	compB := NewComp[B]()
+	compC := NewComp[C]()
+	compD := NewComp[D]()
+	compE := NewComp[E]()
+	compF := NewComp[F]()
+	compG := NewComp[G]()
+	compH := NewComp[H]()
+	compI := NewComp[I]()
+	compJ := NewComp[J]()
+	compK := NewComp[K]()
+	compL := NewComp[L]()
+
+	return Bundle12[A, B, C, D, E, F, G, H, I, J, K, L]{
+		mask: buildArchMaskFromId(compA.CompId(), compB.CompId(), compC.CompId(), compD.CompId(), compE.CompId(), compF.CompId(), compG.CompId(), compH.CompId(), compI.CompId(), compJ.CompId(), compK.CompId(), compL.CompId()),
+
+		compA: compA,
+		compB: compB,
+		compC: compC,
+		compD: compD,
+		compE: compE,
+		compF: compF,
+		compG: compG,
+		compH: compH,
+		compI: compI,
+		compJ: compJ,
+		compK: compK,
+		compL: compL,
+	}
+}
+
+// Writes the components to the entity specified at id. If the entity doesn't exist yet, it will be created.
+// Values are written directly into their component slices, so this doesn't allocate unless an archetype needs to grow.
+func (bundle Bundle12[A, B, C, D, E, F, G, H, I, J, K, L]) Write(world *World, id Id, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) {
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+	storageJ := getStorageByCompId[J](world.engine, bundle.compJ.compId)
+	storageK := getStorageByCompId[K](world.engine, bundle.compK.compId)
+	storageL := getStorageByCompId[L](world.engine, bundle.compL.compId)
+
+	loc := world.allocateMove(id, bundle.mask)
+
+	writeArch(world.engine, loc, id, storageA, a)
+	writeArch(world.engine, loc, id, storageB, b)
+	writeArch(world.engine, loc, id, storageC, c)
+	writeArch(world.engine, loc, id, storageD, d)
+	writeArch(world.engine, loc, id, storageE, e)
+	writeArch(world.engine, loc, id, storageF, f)
+	writeArch(world.engine, loc, id, storageG, g)
+	writeArch(world.engine, loc, id, storageH, h)
+	writeArch(world.engine, loc, id, storageI, i)
+	writeArch(world.engine, loc, id, storageJ, j)
+	writeArch(world.engine, loc, id, storageK, k)
+	writeArch(world.engine, loc, id, storageL, l)
+
+	world.engine.runFinalizedHooks(id)
+}
+
+// Spawns a new entity with the supplied components and returns its Id
+func (bundle Bundle12[A, B, C, D, E, F, G, H, I, J, K, L]) Spawn(world *World, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) Id {
+	id := world.NewId()
+	bundle.Write(world, id, a, b, c, d, e, f, g, h, i, j, k, l)
+	return id
+}
+
+// Spawns n new entities which all start with the supplied components, and returns their Ids.
+// The rows are allocated together and each component column is filled in bulk, then add hooks are run for each entity
+func (bundle Bundle12[A, B, C, D, E, F, G, H, I, J, K, L]) SpawnBatch(world *World, n int, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	storageA := getStorageByCompId[A](world.engine, bundle.compA.compId)
+	storageB := getStorageByCompId[B](world.engine, bundle.compB.compId)
+	storageC := getStorageByCompId[C](world.engine, bundle.compC.compId)
+	storageD := getStorageByCompId[D](world.engine, bundle.compD.compId)
+	storageE := getStorageByCompId[E](world.engine, bundle.compE.compId)
+	storageF := getStorageByCompId[F](world.engine, bundle.compF.compId)
+	storageG := getStorageByCompId[G](world.engine, bundle.compG.compId)
+	storageH := getStorageByCompId[H](world.engine, bundle.compH.compId)
+	storageI := getStorageByCompId[I](world.engine, bundle.compI.compId)
+	storageJ := getStorageByCompId[J](world.engine, bundle.compJ.compId)
+	storageK := getStorageByCompId[K](world.engine, bundle.compK.compId)
+	storageL := getStorageByCompId[L](world.engine, bundle.compL.compId)
+
+	ids := world.newIds(n)
+	loc := world.allocateBatch(ids, bundle.mask)
+	start := int(loc.index)
+
+	writeArchBatch(world.engine, loc.archId, start, ids, storageA, a)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageB, b)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageC, c)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageD, d)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageE, e)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageF, f)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageG, g)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageH, h)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageI, i)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageJ, j)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageK, k)
+	writeArchBatch(world.engine, loc.archId, start, ids, storageL, l)
+
+	world.engine.runBatchHooks(ids, bundle.mask)
+	return ids
+}
+
+// Adds the components to the bundler, this is useful if you are building up an entity with a CommandQueue
+func (bundle Bundle12[A, B, C, D, E, F, G, H, I, J, K, L]) Unbundle(bun *Bundler, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) {
+
+	bundle.compA.UnbundleVal(bun, a)
+	bundle.compB.UnbundleVal(bun, b)
+	bundle.compC.UnbundleVal(bun, c)
+	bundle.compD.UnbundleVal(bun, d)
+	bundle.compE.UnbundleVal(bun, e)
+	bundle.compF.UnbundleVal(bun, f)
+	bundle.compG.UnbundleVal(bun, g)
+	bundle.compH.UnbundleVal(bun, h)
+	bundle.compI.UnbundleVal(bun, i)
+	bundle.compJ.UnbundleVal(bun, j)
+	bundle.compK.UnbundleVal(bun, k)
+	bundle.compL.UnbundleVal(bun, l)
+}
@@ -0,0 +1,108 @@
+package ecs
+
+import "testing"
+
+func TestBundleWrite(t *testing.T) {
+	world := NewWorld()
+	bundle := NewBundle2[position, velocity]()
+
+	id := bundle.Spawn(world, position{1, 1, 1}, velocity{2, 2, 2})
+
+	p, ok := Read[position](world, id)
+	check(t, ok)
+	compare(t, p, position{1, 1, 1})
+	v, ok := Read[velocity](world, id)
+	check(t, ok)
+	compare(t, v, velocity{2, 2, 2})
+
+	// Rewriting in place should keep the entity where it is
+	bundle.Write(world, id, position{3, 3, 3}, velocity{4, 4, 4})
+	p, _ = Read[position](world, id)
+	compare(t, p, position{3, 3, 3})
+	compare(t, world.engine.count(position{}, velocity{}), 1)
+
+	// Writing onto an existing entity should move it and keep its old components
+	accel := NewBundle1[acceleration]()
+	accel.Write(world, id, acceleration{5, 5, 5})
+	a, ok := Read[acceleration](world, id)
+	check(t, ok)
+	compare(t, a, acceleration{5, 5, 5})
+	v, ok = Read[velocity](world, id)
+	check(t, ok)
+	compare(t, v, velocity{4, 4, 4})
+	compare(t, world.engine.count(position{}, velocity{}, acceleration{}), 1)
+}
+
+func TestBundleWriteHooks(t *testing.T) {
+	world := NewWorld()
+	bundle := NewBundle2[position, velocity]()
+
+	added := 0
+	world.SetHookOnAdd(C(velocity{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		added++
+	}))
+
+	id := bundle.Spawn(world, position{}, velocity{})
+	compare(t, added, 1)
+
+	// Rewriting an existing component isn't an add
+	bundle.Write(world, id, position{}, velocity{})
+	compare(t, added, 1)
+}
+
+func TestBundleWriteAllocs(t *testing.T) {
+	world := NewWorld()
+	bundle := NewBundle4[position, velocity, acceleration, radius]()
+
+	id := bundle.Spawn(world, position{}, velocity{}, acceleration{}, radius{})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		bundle.Write(world, id, position{1, 2, 3}, velocity{4, 5, 6}, acceleration{7, 8, 9}, radius{10})
+	})
+	compare(t, allocs, 0)
+
+	allocs = testing.AllocsPerRun(1000, func() {
+		bundle.Spawn(world, position{1, 2, 3}, velocity{4, 5, 6}, acceleration{7, 8, 9}, radius{10})
+	})
+	compare(t, allocs, 0)
+}
+
+func TestBundlerReuse(t *testing.T) {
+	world := NewWorld()
+	bun := &Bundler{}
+
+	positionId.UnbundleVal(bun, position{1, 1, 1})
+	velocityId.UnbundleVal(bun, velocity{2, 2, 2})
+	positionId.UnbundleVal(bun, position{3, 3, 3}) // Overwrites the first position
+	check(t, bun.Has(position{}))
+	check(t, bun.Has(velocity{}))
+	check(t, !bun.Has(radius{}))
+	compare(t, len(bun.Components), 2)
+
+	id := world.NewId()
+	bun.Write(world, id)
+	p, ok := Read[position](world, id)
+	check(t, ok)
+	compare(t, p, position{3, 3, 3})
+
+	// Cleared bundlers keep their boxes, but only write what was set after the clear
+	bun.Clear()
+	check(t, !bun.Has(position{}))
+	radiusId.UnbundleVal(bun, radius{4})
+	bun.Remove(velocityId.CompId())
+
+	id2 := world.NewId()
+	bun.Write(world, id2)
+	_, ok = Read[position](world, id2)
+	check(t, !ok)
+	r, ok := Read[radius](world, id2)
+	check(t, ok)
+	compare(t, r, radius{4})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		bun.Clear()
+		positionId.UnbundleVal(bun, position{5, 5, 5})
+		velocityId.UnbundleVal(bun, velocity{6, 6, 6})
+	})
+	compare(t, allocs, 0)
+}
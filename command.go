@@ -287,6 +287,12 @@ func (c *CommandQueue) SetPrewrite(lambda func(EntityCommand)) {
 // 	c.preDelete = lambda
 // }
 
+// Drops every pending command
+func (c *CommandQueue) clear() {
+	c.commands = c.commands[:0]
+	c.currentBundlerIndex = 0
+}
+
 func (c *CommandQueue) Execute() {
 	// Perform all commands
 	// Note: We must check length every time in case calling one command adds more commands
@@ -297,6 +303,9 @@ func (c *CommandQueue) Execute() {
 	// Cleanup Queue
 	c.commands = c.commands[:0]
 	c.currentBundlerIndex = 0
+
+	// This is a safe point, so we can compact any fragmented archetypes
+	c.world.Compact()
 }
 
 // TODO: Maybe?
@@ -0,0 +1,73 @@
+package ecs
+
+import "time"
+
+// Controls when the holes left behind by deleted and moved entities get compacted. Every view has to skip holes while iterating, so heavily fragmented archetypes get slow.
+// Compaction only runs at safe points: at the end of CommandQueue.Execute (which the scheduler runs after every system), or whenever you call World.Compact.
+// Compacting an archetype moves entities around inside of it, so any pointers you got from Read or a view are invalid afterwards.
+type CompactionPolicy struct {
+	HoleRatio float64       // An archetype is compacted once this fraction of its rows are holes. Set to 0 to disable automatic compaction
+	MinHoles  int           // Archetypes with fewer holes than this are never compacted automatically
+	Budget    time.Duration // The maximum time to spend compacting at each safe point, 0 means no limit. At least one archetype is always compacted
+}
+
+// A reasonable policy for most games, you can enable it with world.SetCompactionPolicy(ecs.DefaultCompactionPolicy)
+var DefaultCompactionPolicy = CompactionPolicy{
+	HoleRatio: 0.25,
+	MinHoles:  64,
+	Budget:    500 * time.Microsecond,
+}
+
+// Sets the policy used to automatically compact archetypes. The default policy is disabled, which means that holes are only compacted by CleanupHoles.
+// You can also set this when creating the world with WorldOptions
+func (w *World) SetCompactionPolicy(policy CompactionPolicy) {
+	w.compaction = policy
+	w.engine.holesChanged = true // Recheck everything with the new policy
+}
+
+// Returns true if the archetype is fragmented enough to be compacted
+func (p CompactionPolicy) shouldCompact(lookup *lookupList) bool {
+	holes := len(lookup.holes)
+	if holes == 0 || holes < p.MinHoles {
+		return false
+	}
+	return float64(holes) >= p.HoleRatio*float64(len(lookup.id))
+}
+
+// Incrementally compacts the archetypes which need it according to the world's CompactionPolicy.
+// Archetypes are visited round robin, so if the budget runs out the next call will continue where this one stopped.
+// This must not be called while iterating a view
+func (w *World) Compact() {
+	policy := w.compaction
+	if policy.HoleRatio <= 0 {
+		return
+	}
+	if !w.engine.holesChanged {
+		return // Nothing has changed since the last time everything was compacted
+	}
+	if w.engine.iterating > 0 {
+		return // Not a safe point, commands were executed inside of a view's map
+	}
+
+	var start time.Time
+	if policy.Budget > 0 {
+		start = time.Now()
+	}
+
+	numArchetypes := len(w.engine.lookup)
+	for i := 0; i < numArchetypes; i++ {
+		archId := archetypeId((w.compactCursor + i) % numArchetypes)
+		if !policy.shouldCompact(w.engine.lookup[archId]) {
+			continue
+		}
+
+		w.cleanupArchetype(archId)
+
+		if policy.Budget > 0 && time.Since(start) >= policy.Budget {
+			w.compactCursor = int(archId) + 1
+			return // Out of time, the rest will be checked next time
+		}
+	}
+
+	w.engine.holesChanged = false
+}
@@ -0,0 +1,68 @@
+package ecs
+
+import "testing"
+
+func TestCompactionPolicy(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 100)
+	for i := range ids {
+		ids[i] = world.Spawn(C(position{float64(i), 0, 0}))
+	}
+	other := world.Spawn(C(velocity{}))
+	Delete(world, world.Spawn(C(velocity{})))
+
+	loc, _ := world.arch.Get(ids[0])
+	lookup := world.engine.lookup[loc.archId]
+
+	// Disabled by default
+	for i := 0; i < 30; i++ {
+		Delete(world, ids[i])
+	}
+	world.Cmd().Execute()
+	compare(t, len(lookup.holes), 30)
+
+	// Below the ratio
+	world.SetCompactionPolicy(CompactionPolicy{HoleRatio: 0.5, MinHoles: 10})
+	world.Cmd().Execute()
+	compare(t, len(lookup.holes), 30)
+
+	// Below the minimum number of holes
+	otherLoc, _ := world.arch.Get(other)
+	compare(t, len(world.engine.lookup[otherLoc.archId].holes), 1)
+
+	for i := 30; i < 60; i++ {
+		Delete(world, ids[i])
+	}
+	world.Cmd().Execute()
+	compare(t, len(lookup.holes), 0)
+	compare(t, len(lookup.id), 40)
+	compare(t, len(world.engine.lookup[otherLoc.archId].holes), 1)
+
+	// Entities were moved into the holes, but are still readable
+	for i := 60; i < 100; i++ {
+		p, ok := Read[position](world, ids[i])
+		check(t, ok)
+		compare(t, p, position{float64(i), 0, 0})
+	}
+	compare(t, Query1[position](world).Count(), 40)
+}
+
+func TestCompactionBudget(t *testing.T) {
+	world := NewWorld()
+	world.SetCompactionPolicy(CompactionPolicy{HoleRatio: 0.1, Budget: 1}) // Budget runs out after the first archetype
+
+	a := world.Spawn(C(position{}))
+	b := world.Spawn(C(velocity{}))
+	Delete(world, world.Spawn(C(position{})))
+	Delete(world, world.Spawn(C(velocity{})))
+
+	locA, _ := world.arch.Get(a)
+	locB, _ := world.arch.Get(b)
+	lookupA := world.engine.lookup[locA.archId]
+	lookupB := world.engine.lookup[locB.archId]
+
+	world.Compact()
+	compare(t, len(lookupA.holes)+len(lookupB.holes), 1)
+	world.Compact()
+	compare(t, len(lookupA.holes)+len(lookupB.holes), 0)
+}
@@ -2,6 +2,24 @@ package ecs
 
 type CompId uint16
 
+// Returns itself, this lets you pass a CompId anywhere that accepts a component to identify it. For example: ecs.With(compId)
+func (c CompId) CompId() CompId {
+	return c
+}
+
+type compIder interface {
+	CompId() CompId
+}
+
+// Returns the componentId of a component value. Values which can report their own id (eg. Components, DynamicComps, and CompIds) are used directly, anything else is looked up by its type
+func compIdOfAny(c any) CompId {
+	ider, ok := c.(compIder)
+	if ok {
+		return ider.CompId()
+	}
+	return name(c)
+}
+
 func NewComp[T any]() comp[T] {
 	var t T
 	return Comp(t)
@@ -30,6 +48,7 @@ func (c comp[T]) newBox(val T) box[T] {
 
 type W struct {
 	engine  *archEngine
+	id      Id
 	archId  archetypeId
 	index   int
 	bundler *Bundler
@@ -44,6 +63,12 @@ type Component interface {
 	CompId() CompId
 }
 
+// Implemented by components that can write their value to a whole batch of rows at once, so that SpawnBatch can fill each column in one pass.
+// The rows must have been allocated with allocateBatch, and like writeArchBatch this doesn't mark any hooks
+type batchWriter interface {
+	writeBatch(e *archEngine, archId archetypeId, start int, ids []Id)
+}
+
 // This type is used to box a component with all of its type info so that it implements the component interface. I would like to get rid of this and simplify the APIs
 type box[T any] struct {
 	val T
@@ -60,6 +85,11 @@ func (c box[T]) CompWrite(wd W) {
 	c.WriteVal(wd, c.val)
 }
 
+func (c box[T]) writeBatch(e *archEngine, archId archetypeId, start int, ids []Id) {
+	store := getStorageByCompId[T](e, c.compId)
+	writeArchBatch(e, archId, start, ids, store, c.val)
+}
+
 func (c box[T]) OnInsert(ent EntityCommand) {
 	inserter, ok := any(c.val).(onInsert)
 	if ok {
@@ -104,7 +134,7 @@ func (c comp[T]) WriteVal(cw W, val T) {
 		c.UnbundleVal(cw.bundler, val)
 	} else {
 		store := getStorageByCompId[T](cw.engine, c.CompId())
-		writeArch(cw.engine, cw.archId, cw.index, store, val)
+		writeArch(cw.engine, entLoc{cw.archId, uint32(cw.index)}, cw.id, store, val)
 	}
 }
 
@@ -116,15 +146,13 @@ func (c comp[T]) WriteVal(cw W, val T) {
 func (c comp[T]) UnbundleVal(bun *Bundler, val T) {
 	compId := c.compId
 	bun.archMask.addComponent(compId)
-	bun.Set[compId] = true
-	if bun.Components[compId] == nil {
+	idx := bun.findIndex(compId)
+	if idx < 0 {
 		// Note: We need a pointer so that we dont do an allocation every time we set it
 		box := c.newBox(val)
-		bun.Components[compId] = &box
+		bun.Components = append(bun.Components, &box)
 	} else {
-		rwComp := bun.Components[compId].(*box[T])
+		rwComp := bun.Components[idx].(*box[T])
 		rwComp.val = val
 	}
-
-	bun.maxComponentIdAdded = max(bun.maxComponentIdAdded, compId)
 }
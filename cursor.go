@@ -0,0 +1,87 @@
+package ecs
+
+import (
+	"slices"
+	"time"
+)
+
+// Lets a view be mapped a little bit at a time, so that expensive systems (like pathfinding or AI planning) can spread their work across multiple frames.
+// Each call to MapIdCursor stops once MaxEntities have been visited or the Budget has run out, and the next call resumes from the same position.
+// Entities can be added, removed or moved between calls. Entities that are added or moved behind the cursor are picked up on the next pass.
+// While a cursor is paused in the middle of a pass, compaction leaves the holes that it has already passed alone, so that entities it hasn't visited yet aren't moved behind it. If you stop using a cursor in the middle of a pass, call Reset so that those holes can be compacted again.
+type QueryCursor struct {
+	MaxEntities int           // The maximum number of entities to visit per call, 0 means no limit
+	Budget      time.Duration // The maximum time to spend per call, 0 means no limit. This is only checked every few entities
+
+	archId archetypeId // The position to resume from
+	index  int
+	engine *archEngine // Set while the cursor is paused in the middle of a pass
+
+	visited    int // Per call state
+	sinceCheck int
+	start      time.Time
+}
+
+// The number of rows between each check of the time budget, because checking the time is expensive compared to most lambdas
+const cursorTimeCheckInterval = 32
+
+// Moves the cursor back to the start of the view
+func (c *QueryCursor) Reset() {
+	c.archId = 0
+	c.index = 0
+	c.release()
+}
+
+// Registers the cursor with the engine when it stops in the middle of a pass, so that compaction knows which rows it has already passed
+func (c *QueryCursor) pause(e *archEngine) {
+	if c.engine == e {
+		return
+	}
+	c.release()
+	c.engine = e
+	e.cursors = append(e.cursors, c)
+}
+
+func (c *QueryCursor) release() {
+	if c.engine == nil {
+		return
+	}
+	c.engine.cursors = slices.DeleteFunc(c.engine.cursors, func(other *QueryCursor) bool {
+		return other == c
+	})
+	c.engine = nil
+}
+
+// Returns the first row of the archetype that every paused cursor still has to visit. Holes before it mustn't be filled, because that would move an entity that hasn't been visited behind a cursor
+func (e *archEngine) compactionFloor(archId archetypeId) int {
+	floor := 0
+	for _, c := range e.cursors {
+		if c.archId == archId {
+			floor = max(floor, c.index)
+		}
+	}
+	return floor
+}
+
+func (c *QueryCursor) begin() {
+	c.visited = 0
+	c.sinceCheck = 0
+	if c.Budget > 0 {
+		c.start = time.Now()
+	}
+}
+
+// Returns true if the cursor should stop before visiting the next row
+func (c *QueryCursor) exhausted() bool {
+	if c.MaxEntities > 0 && c.visited >= c.MaxEntities {
+		return true
+	}
+	if c.Budget > 0 {
+		c.sinceCheck++
+		if c.sinceCheck >= cursorTimeCheckInterval {
+			c.sinceCheck = 0
+			return time.Since(c.start) >= c.Budget
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package ecs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueryCursor(t *testing.T) {
+	world := NewWorld()
+	for i := 0; i < 10; i++ {
+		world.Spawn(C(position{}))
+		world.Spawn(C(position{}), C(velocity{}))
+	}
+
+	query := Query1[position](world)
+	cursor := &QueryCursor{MaxEntities: 7}
+	visits := make(map[Id]int)
+	mapCursor := func() bool {
+		return query.MapIdCursor(cursor, func(id Id, p *position) {
+			visits[id]++
+		})
+	}
+
+	check(t, !mapCursor())
+	compare(t, len(visits), 7)
+	check(t, !mapCursor())
+	compare(t, len(visits), 14)
+
+	// Structural changes between calls are fine, entities added ahead of the cursor are visited in this pass
+	Delete(world, world.Spawn(C(position{}), C(velocity{})))
+	late := world.Spawn(C(position{}), C(velocity{}))
+	world.Write(world.Spawn(C(position{})), C(acceleration{}))
+	check(t, !mapCursor())
+	check(t, mapCursor())
+	compare(t, visits[late], 1)
+	for id, count := range visits {
+		compare(t, count, 1)
+		check(t, world.Exists(id))
+	}
+
+	// The next pass starts over
+	check(t, !mapCursor())
+	count := 0
+	for _, c := range visits {
+		if c == 2 {
+			count++
+		}
+	}
+	compare(t, count, 7)
+}
+
+func TestQueryCursorBudget(t *testing.T) {
+	world := NewWorld()
+	for i := 0; i < 1000; i++ {
+		world.Spawn(C(position{}))
+	}
+
+	query := Query1[position](world)
+	cursor := &QueryCursor{Budget: time.Millisecond}
+
+	visited := 0
+	done := query.MapIdCursor(cursor, func(id Id, p *position) {
+		time.Sleep(50 * time.Microsecond)
+		visited++
+	})
+	check(t, !done)
+	check(t, visited < 1000)
+	check(t, visited >= cursorTimeCheckInterval-1)
+
+	// Without any limits the rest of the view is finished
+	cursor.Budget = 0
+	check(t, query.MapIdCursor(cursor, func(id Id, p *position) {
+		visited++
+	}))
+	compare(t, visited, 1000)
+}
+
+func TestQueryCursorCompaction(t *testing.T) {
+	world := NewWorld()
+	ids := SpawnBatch(world, 10, C(position{}))
+
+	query := Query1[position](world)
+	cursor := &QueryCursor{MaxEntities: 4}
+	visits := make(map[Id]int)
+	mapCursor := func() bool {
+		return query.MapIdCursor(cursor, func(id Id, p *position) {
+			visits[id]++
+		})
+	}
+
+	// Compacting would normally move the entities at the end into the holes that the cursor has already passed
+	check(t, !mapCursor())
+	for _, id := range ids[:3] {
+		Delete(world, id)
+	}
+	Delete(world, ids[5])
+	world.CleanupHoles()
+	for !mapCursor() {
+	}
+	compare(t, len(visits), 9) // The three that were deleted were already visited
+	for _, id := range ids[3:] {
+		if id != ids[5] {
+			compare(t, visits[id], 1)
+		}
+	}
+
+	// Once the pass is over, the holes can be compacted
+	loc, _ := world.arch.Get(ids[3])
+	lookup := world.engine.lookup[loc.archId]
+	check(t, len(lookup.holes) > 0)
+	world.CleanupHoles()
+	compare(t, len(lookup.holes), 0)
+	compare(t, len(lookup.id), 6)
+
+	// Automatic compaction when commands are executed works the same way
+	world.SetCompactionPolicy(CompactionPolicy{HoleRatio: 0.1, MinHoles: 1})
+	clear(visits)
+	check(t, !mapCursor())
+	for id := range visits {
+		Delete(world, id)
+	}
+	world.Cmd().Execute() // Compacts, because this is a safe point
+	for !mapCursor() {
+	}
+	compare(t, len(visits), 6)
+	for _, count := range visits {
+		compare(t, count, 1)
+	}
+	compare(t, query.Count(), 2)
+}
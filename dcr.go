@@ -1,14 +1,19 @@
 package ecs
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
-// TODO: You should move to this (ie archetype graph (or bitmask?). maintain the current archetype node, then traverse to nodes (and add new ones) based on which components are added): https://ajmmertens.medium.com/building-an-ecs-2-archetypes-and-vectorization-fe21690805f9
+// Note: Single component transitions are cached as edges of an archetype graph (see graph.go), so this mask lookup is mostly used for spawns and multi component changes: https://ajmmertens.medium.com/building-an-ecs-2-archetypes-and-vectorization-fe21690805f9
 // Dynamic component Registry
 type componentRegistry struct {
 	archSet  [][]archetypeId               // Contains the set of archetypeIds that have this component
 	archMask map[archetypeMask]archetypeId // Contains a mapping of archetype bitmasks to archetypeIds
 
 	revArchMask []archetypeMask // Contains the reverse mapping of archetypeIds to archetype masks. Indexed by archetypeId
+
+	free []archetypeId // Archetypes that were removed, and can be reused for new masks
 }
 
 func newComponentRegistry() *componentRegistry {
@@ -27,10 +32,13 @@ func (r *componentRegistry) getArchetypeId(engine *archEngine, mask archetypeMas
 		archId = engine.newArchetypeId(mask, componentIds)
 		r.archMask[mask] = archId
 
-		if int(archId) != len(r.revArchMask) {
+		if int(archId) < len(r.revArchMask) {
+			r.revArchMask[archId] = mask // Reused a removed archetype
+		} else if int(archId) == len(r.revArchMask) {
+			r.revArchMask = append(r.revArchMask, mask)
+		} else {
 			panic(fmt.Sprintf("ecs: archId must increment. Expected: %d, Got: %d", len(r.revArchMask), archId))
 		}
-		r.revArchMask = append(r.revArchMask, mask)
 
 		// Add this archetypeId to every component's archList
 		for _, compId := range componentIds {
@@ -40,6 +48,20 @@ func (r *componentRegistry) getArchetypeId(engine *archEngine, mask archetypeMas
 	return archId
 }
 
+// Removes the archetype from the registry and adds it to the free list
+func (r *componentRegistry) removeArchetype(archId archetypeId) {
+	mask := r.revArchMask[archId]
+	delete(r.archMask, mask)
+	for _, compId := range mask.getComponentList() {
+		r.archSet[compId] = slices.DeleteFunc(r.archSet[compId], func(id archetypeId) bool {
+			return id == archId
+		})
+	}
+
+	r.revArchMask[archId] = blankArchMask
+	r.free = append(r.free, archId)
+}
+
 // This is mostly for the without filter
 func (r *componentRegistry) archIdOverlapsMask(archId archetypeId, compArchMask archetypeMask) bool {
 	archMaskToCheck := r.revArchMask[archId]
@@ -33,12 +33,10 @@ func (e *archEngine) ReadEntity(loc entLoc, id Id) *Entity {
 	if lookup == nil {
 		panic("Archetype doesn't have lookup list")
 	}
-	index := int(loc.index)
-
 	ent := NewEntity()
 	for n := range e.compStorage {
 		if e.compStorage[n] != nil {
-			e.compStorage[n].ReadToEntity(ent, loc.archId, index)
+			e.compStorage[n].ReadToEntity(ent, loc, id)
 		}
 	}
 	return ent
@@ -53,7 +51,7 @@ func (e *archEngine) ReadRawEntity(loc entLoc, id Id) *RawEntity {
 	ent := NewRawEntity()
 	for n := range e.compStorage {
 		if e.compStorage[n] != nil {
-			e.compStorage[n].ReadToRawEntity(ent, loc.archId, int(loc.index))
+			e.compStorage[n].ReadToRawEntity(ent, loc, id)
 		}
 	}
 	return ent
@@ -67,7 +65,7 @@ func readArch[T any](e *archEngine, loc entLoc, id Id) (T, bool) {
 	}
 
 	// Get the dynamic componentSliceStorage
-	n := name(ret)
+	n := compIdOf[T]()
 	ss := e.compStorage[n]
 	if ss == nil {
 		return ret, false
@@ -75,27 +73,24 @@ func readArch[T any](e *archEngine, loc entLoc, id Id) (T, bool) {
 
 	storage, ok := ss.(*componentStorage[T])
 	if !ok {
-		panic(fmt.Sprintf("Wrong componentSliceStorage[T] type: %d != %d", name(ss), name(ret)))
+		panic(fmt.Sprintf("Wrong componentSliceStorage[T] type: %d != %d", name(ss), n))
 	}
 
-	// Get the underlying Archetype's componentSlice
-	cSlice, ok := storage.slice.Get(loc.archId)
-	if !ok {
+	val := storage.get(loc, id)
+	if val == nil {
 		return ret, false
 	}
-
-	return cSlice.comp[loc.index], true
+	return *val, true
 }
 
 func readPtrArch[T any](e *archEngine, loc entLoc, id Id) *T {
-	var ret T
 	lookup := e.lookup[loc.archId]
 	if lookup == nil {
 		return nil
 	}
 
 	// Get the dynamic componentSliceStorage
-	n := name(ret)
+	n := compIdOf[T]()
 	ss := e.compStorage[n]
 	if ss == nil {
 		return nil
@@ -103,14 +98,8 @@ func readPtrArch[T any](e *archEngine, loc entLoc, id Id) *T {
 
 	storage, ok := ss.(*componentStorage[T])
 	if !ok {
-		panic(fmt.Sprintf("Wrong componentSliceStorage[T] type: %d != %d", name(ss), name(ret)))
-	}
-
-	// Get the underlying Archetype's componentSlice
-	cSlice, ok := storage.slice.Get(loc.archId)
-	if !ok {
-		return nil
+		panic(fmt.Sprintf("Wrong componentSliceStorage[T] type: %d != %d", name(ss), n))
 	}
 
-	return &cSlice.comp[loc.index]
+	return storage.get(loc, id)
 }
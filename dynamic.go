@@ -0,0 +1,446 @@
+package ecs
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// Describes one field of a dynamic component. Fields are laid out in order using Go's struct layout rules
+type DynamicField struct {
+	Name string
+	Type reflect.Type
+}
+
+// A component type that is defined at runtime rather than by a Go type, which is useful for scripting and modding.
+// Dynamic component data is stored as raw bytes, and it can be read and written as a []byte or as a reflect.Value (if a field schema was supplied).
+// DynamicComp implements Component, writing it directly will add a zeroed component to the entity.
+type DynamicComp struct {
+	compId CompId
+	name   string
+	size   int
+	typ    reflect.Type // The struct type built from the schema, or nil if no schema was supplied
+}
+
+var dynamicCompMutex sync.Mutex
+var registeredDynamicComps = make(map[string]DynamicComp)
+
+// Registers a dynamic component with the supplied name and size in bytes. If fields are supplied, they must exactly fill size and can't contain any pointers.
+// Registering the same name again returns the original component, but it will panic if the size or fields don't match.
+// Like every other component id, dynamic component ids are shared by all worlds in the process.
+func RegisterDynamicComp(name string, size int, fields ...DynamicField) DynamicComp {
+	if size < 0 {
+		panic(fmt.Sprintf("ecs: dynamic component %s has negative size: %d", name, size))
+	}
+
+	var typ reflect.Type
+	if len(fields) > 0 {
+		structFields := make([]reflect.StructField, len(fields))
+		for i, f := range fields {
+			if hasPointers(f.Type) {
+				panic(fmt.Sprintf("ecs: dynamic component %s field %s can't contain pointers: %v", name, f.Name, f.Type))
+			}
+			structFields[i] = reflect.StructField{
+				Name: f.Name,
+				Type: f.Type,
+			}
+		}
+		typ = reflect.StructOf(structFields)
+
+		if int(typ.Size()) != size {
+			panic(fmt.Sprintf("ecs: dynamic component %s has size %d, but its fields need %d bytes", name, size, typ.Size()))
+		}
+	}
+
+	dynamicCompMutex.Lock()
+	defer dynamicCompMutex.Unlock()
+
+	comp, ok := registeredDynamicComps[name]
+	if ok {
+		if comp.size != size || comp.typ != typ {
+			panic(fmt.Sprintf("ecs: dynamic component %s was already registered with a different layout", name))
+		}
+		return comp
+	}
+
+	comp = DynamicComp{
+		compId: registeredComponents.reserve(name),
+		name:   name,
+		size:   size,
+		typ:    typ,
+	}
+	var builder storageBuilder = dynamicStorageBuilder{comp}
+	componentStorageLookup[comp.compId].Store(&builder)
+
+	registeredDynamicComps[name] = comp
+	return comp
+}
+
+// Returns a previously registered dynamic component by name
+func LookupDynamicComp(name string) (DynamicComp, bool) {
+	dynamicCompMutex.Lock()
+	defer dynamicCompMutex.Unlock()
+
+	comp, ok := registeredDynamicComps[name]
+	return comp, ok
+}
+
+// Returns true if the type (recursively) contains any pointers, which can't be stored in raw byte columns
+func hasPointers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return false
+	case reflect.Array:
+		return hasPointers(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasPointers(t.Field(i).Type) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (c DynamicComp) CompId() CompId {
+	return c.compId
+}
+
+// Writes a zeroed component
+func (c DynamicComp) CompWrite(wd W) {
+	c.writeBytes(wd, nil)
+}
+
+// Rows from allocateBatch are already zeroed, so there's nothing to write
+func (c DynamicComp) writeBatch(e *archEngine, archId archetypeId, start int, ids []Id) {}
+
+// Returns the name that the component was registered with
+func (c DynamicComp) Name() string {
+	return c.name
+}
+
+// Returns the size of the component in bytes
+func (c DynamicComp) Size() int {
+	return c.size
+}
+
+// Returns the struct type built from the component's fields, or nil if it was registered without any fields
+func (c DynamicComp) Type() reflect.Type {
+	return c.typ
+}
+
+// Returns a component which writes the supplied bytes. The data must be exactly the size of the component
+func (c DynamicComp) With(data []byte) Component {
+	if len(data) != c.size {
+		panic(fmt.Sprintf("ecs: dynamic component %s expects %d bytes, got %d", c.name, c.size, len(data)))
+	}
+	return dynamicValue{c, data}
+}
+
+// Returns a component which writes the supplied value. The value must have the same type as the component's schema
+func (c DynamicComp) WithValue(val reflect.Value) Component {
+	if c.typ == nil {
+		panic(fmt.Sprintf("ecs: dynamic component %s has no fields", c.name))
+	}
+	if val.Type() != c.typ {
+		panic(fmt.Sprintf("ecs: dynamic component %s expects a value of type %v, got %v", c.name, c.typ, val.Type()))
+	}
+
+	data := make([]byte, c.size)
+	if c.size > 0 {
+		reflect.NewAt(c.typ, unsafe.Pointer(&data[0])).Elem().Set(val)
+	}
+	return dynamicValue{c, data}
+}
+
+// Returns an addressable reflect.Value that reads and writes the supplied component bytes
+func (c DynamicComp) value(data []byte) reflect.Value {
+	if c.typ == nil {
+		panic(fmt.Sprintf("ecs: dynamic component %s has no fields", c.name))
+	}
+	if c.size == 0 {
+		return reflect.New(c.typ).Elem()
+	}
+	return reflect.NewAt(c.typ, unsafe.Pointer(&data[0])).Elem()
+}
+
+func (c DynamicComp) writeBytes(wd W, data []byte) {
+	if wd.bundler != nil {
+		bun := wd.bundler
+		bun.archMask.addComponent(c.compId)
+		idx := bun.findIndex(c.compId)
+		if idx < 0 {
+			// Note: We need a pointer so that we dont do an allocation every time we set it
+			val := &dynamicValue{c, make([]byte, c.size)}
+			copy(val.data, data)
+			bun.Components = append(bun.Components, val)
+		} else {
+			val := bun.Components[idx].(*dynamicValue)
+			clear(val.data)
+			copy(val.data, data)
+		}
+		return
+	}
+
+	store := wd.engine.getStorage(c.compId).(*dynamicStorage)
+	store.write(wd.archId, wd.index, data)
+}
+
+// A dynamic component along with the bytes that should be written for it
+type dynamicValue struct {
+	comp DynamicComp
+	data []byte
+}
+
+func (v dynamicValue) CompId() CompId {
+	return v.comp.compId
+}
+
+func (v dynamicValue) CompWrite(wd W) {
+	v.comp.writeBytes(wd, v.data)
+}
+
+func (v dynamicValue) writeBatch(e *archEngine, archId archetypeId, start int, ids []Id) {
+	store := e.getStorage(v.comp.compId).(*dynamicStorage)
+	store.writeBatch(archId, start, len(ids), v.data)
+}
+
+// Returns the component data as bytes
+func (v dynamicValue) Bytes() []byte {
+	return v.data
+}
+
+// Reads the bytes of a dynamic component on the entity at id. Returns false if the entity doesn't have it.
+// The returned slice points into the world, so modifying it modifies the component.
+// Like ReadPtr, this slice is short lived and can become invalid if any other entity changes in the world
+func ReadDynamic(world *World, id Id, comp DynamicComp) ([]byte, bool) {
+	loc, ok := world.arch.Get(id)
+	if !ok {
+		return nil, false
+	}
+
+	ss := world.engine.compStorage[comp.compId]
+	if ss == nil {
+		return nil, false
+	}
+	list, ok := ss.(*dynamicStorage).slice.Get(loc.archId)
+	if !ok {
+		return nil, false
+	}
+	return list.row(int(loc.index), comp.size), true
+}
+
+// Reads the bytes of a dynamic component from the entity, returns false if the component doesn't exist
+func ReadDynamicFromEntity(ent *Entity, comp DynamicComp) ([]byte, bool) {
+	idx := ent.findIndex(comp.compId)
+	if idx < 0 {
+		return nil, false
+	}
+	val, ok := ent.comp[idx].(dynamicValue)
+	if !ok {
+		return nil, false
+	}
+	return val.data, true
+}
+
+// Reads a dynamic component on the entity at id as a reflect.Value of the component's schema type. Returns false if the entity doesn't have it.
+// The value is addressable and points into the world, so setting fields on it modifies the component.
+// Panics if the component was registered without any fields
+func ReadDynamicValue(world *World, id Id, comp DynamicComp) (reflect.Value, bool) {
+	data, ok := ReadDynamic(world, id, comp)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return comp.value(data), true
+}
+
+// --------------------------------------------------------------------------------
+// - Dynamic Storage
+// --------------------------------------------------------------------------------
+type dynamicStorageBuilder struct {
+	comp DynamicComp
+}
+
+func (b dynamicStorageBuilder) build(compId CompId, allocation int) storage {
+	return &dynamicStorage{
+		comp:       b.comp,
+		size:       b.comp.size,
+		slice:      newMap[archetypeId, *dynamicList](allocation),
+		allocation: allocation,
+	}
+}
+
+// A column of fixed size rows, stored as raw bytes
+type dynamicList struct {
+	data []byte
+}
+
+func (l *dynamicList) row(index int, size int) []byte {
+	start := index * size
+	return l.data[start : start+size : start+size]
+}
+
+// Appends a zeroed row to the end of the list
+func (l *dynamicList) grow(size int) {
+	if len(l.data)+size > cap(l.data) {
+		l.reserve(max(2*cap(l.data), len(l.data)+size, 64))
+	}
+	l.data = l.data[:len(l.data)+size]
+	clear(l.data[len(l.data)-size:])
+}
+
+// Makes sure that the list has a capacity of at least n bytes
+func (l *dynamicList) reserve(n int) {
+	if n <= cap(l.data) {
+		return
+	}
+
+	// Note: We allocate the backing array as uint64s so that every row is 8 byte aligned, which lets us safely point reflect values at it
+	words := make([]uint64, (n+7)/8)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&words[0])), len(words)*8)
+	l.data = data[:copy(data, l.data)]
+}
+
+type dynamicStorage struct {
+	comp       DynamicComp
+	size       int
+	slice      *internalMap[archetypeId, *dynamicList]
+	allocation int // The initial number of rows in each archetype's list
+}
+
+func (ss *dynamicStorage) GetSlice(archId archetypeId) *dynamicList {
+	list, ok := ss.slice.Get(archId)
+	if !ok {
+		list = &dynamicList{}
+		list.reserve(ss.allocation * ss.size)
+		ss.slice.Put(archId, list)
+	}
+	return list
+}
+
+// Note: This will panic if you write past the buffer by more than 1
+func (ss *dynamicStorage) write(archId archetypeId, index int, data []byte) {
+	list := ss.GetSlice(archId)
+	if index*ss.size == len(list.data) {
+		list.grow(ss.size)
+	}
+	row := list.row(index, ss.size)
+	clear(row)
+	copy(row, data)
+}
+
+func (ss *dynamicStorage) ReadToEntity(entity *Entity, loc entLoc, id Id) bool {
+	list, ok := ss.slice.Get(loc.archId)
+	if !ok {
+		return false
+	}
+	data := make([]byte, ss.size)
+	copy(data, list.row(int(loc.index), ss.size))
+	entity.Add(dynamicValue{ss.comp, data})
+	return true
+}
+
+func (ss *dynamicStorage) ReadToRawEntity(entity *RawEntity, loc entLoc, id Id) bool {
+	list, ok := ss.slice.Get(loc.archId)
+	if !ok {
+		return false
+	}
+	entity.comp[ss.comp.compId] = list.row(int(loc.index), ss.size)
+	return true
+}
+
+// Dynamic components without a schema are exposed as byte arrays
+func (ss *dynamicStorage) elemType() reflect.Type {
+	if ss.comp.typ != nil {
+		return ss.comp.typ
+	}
+	return reflect.ArrayOf(ss.size, reflect.TypeFor[byte]())
+}
+
+// Zero sized components don't have any data, so their columns all point here. Like tags, this gives them a non-nil pointer, because nil means that the archetype doesn't have the component
+var zeroSizedRow byte
+
+func (ss *dynamicStorage) column(archId archetypeId) (unsafe.Pointer, bool) {
+	list, ok := ss.slice.Get(archId)
+	if !ok {
+		return nil, false
+	}
+	if ss.size == 0 {
+		return unsafe.Pointer(&zeroSizedRow), true
+	}
+	return unsafe.Pointer(unsafe.SliceData(list.data)), true
+}
+
+func (ss *dynamicStorage) clear() {
+	ss.slice.ForEach(func(archId archetypeId, list *dynamicList) {
+		list.data = list.data[:0]
+	})
+}
+
+func (ss *dynamicStorage) moveTable(from, to archetypeId) {
+	fromList, ok := ss.slice.Get(from)
+	if !ok {
+		return
+	}
+	toList := ss.GetSlice(to)
+	toList.reserve(len(toList.data) + len(fromList.data)) // Note: Reserve first so that append doesn't replace the aligned backing array
+	toList.data = append(toList.data, fromList.data...)
+	fromList.data = fromList.data[:0]
+}
+
+func (ss *dynamicStorage) truncate(archId archetypeId) {
+	list, ok := ss.slice.Get(archId)
+	if !ok {
+		return
+	}
+	list.data = list.data[:0]
+}
+
+func (ss *dynamicStorage) reserve(archId archetypeId, n int) {
+	list := ss.GetSlice(archId)
+	list.reserve(len(list.data) + n*ss.size)
+}
+
+func (ss *dynamicStorage) dropArchetype(archId archetypeId) {
+	ss.slice.Delete(archId)
+}
+
+func (ss *dynamicStorage) allocateBatch(archId archetypeId, n int) {
+	list := ss.GetSlice(archId)
+	list.grow(n * ss.size)
+}
+
+// Writes the same data to n rows that were allocated with allocateBatch
+func (ss *dynamicStorage) writeBatch(archId archetypeId, start, n int, data []byte) {
+	list := ss.GetSlice(archId)
+	for i := range n {
+		copy(list.row(start+i, ss.size), data)
+	}
+}
+
+func (ss *dynamicStorage) Allocate(archId archetypeId, index int) {
+	ss.write(archId, index, nil)
+}
+
+func (ss *dynamicStorage) moveArchetype(oldLoc, newLoc entLoc) {
+	oldList, _ := ss.slice.Get(oldLoc.archId)
+	ss.write(newLoc.archId, int(newLoc.index), oldList.row(int(oldLoc.index), ss.size))
+}
+
+// Delete is somewhat special because it deletes the index of the archId for the componentSlice
+// but then plugs the hole by pushing the last element of the componentSlice into index
+func (ss *dynamicStorage) Delete(archId archetypeId, index int) {
+	list, ok := ss.slice.Get(archId)
+	if !ok || ss.size == 0 {
+		return
+	}
+
+	lastIndex := len(list.data)/ss.size - 1
+	copy(list.row(index, ss.size), list.row(lastIndex, ss.size))
+	list.data = list.data[:lastIndex*ss.size]
+}
@@ -0,0 +1,132 @@
+package ecs
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+)
+
+var dynHealth = RegisterDynamicComp("test.health", 8,
+	DynamicField{"Current", reflect.TypeFor[float32]()},
+	DynamicField{"Max", reflect.TypeFor[float32]()},
+)
+var dynRaw = RegisterDynamicComp("test.raw", 3)
+
+func healthBytes(current, max float32) []byte {
+	data := make([]byte, 8)
+	binary.NativeEndian.PutUint32(data[0:], math.Float32bits(current))
+	binary.NativeEndian.PutUint32(data[4:], math.Float32bits(max))
+	return data
+}
+
+func TestDynamicRegister(t *testing.T) {
+	again := RegisterDynamicComp("test.health", 8,
+		DynamicField{"Current", reflect.TypeFor[float32]()},
+		DynamicField{"Max", reflect.TypeFor[float32]()},
+	)
+	compare(t, again, dynHealth)
+
+	found, ok := LookupDynamicComp("test.raw")
+	check(t, ok)
+	compare(t, found, dynRaw)
+
+	check(t, dynHealth.CompId() != dynRaw.CompId())
+	check(t, dynHealth.CompId() != compIdOf[position]())
+
+	func() {
+		defer func() { check(t, recover() != nil) }()
+		RegisterDynamicComp("test.pointers", 8, DynamicField{"Ptr", reflect.TypeFor[*int]()})
+	}()
+	func() {
+		defer func() { check(t, recover() != nil) }()
+		RegisterDynamicComp("test.raw", 4) // Mismatched size
+	}()
+}
+
+func TestDynamicReadWrite(t *testing.T) {
+	world := NewWorld()
+
+	id := world.Spawn(C(position{1, 2, 3}), dynHealth.With(healthBytes(5, 10)), dynRaw.With([]byte{1, 2, 3}))
+
+	data, ok := ReadDynamic(world, id, dynHealth)
+	check(t, ok)
+	compare(t, string(data), string(healthBytes(5, 10)))
+
+	raw, ok := ReadDynamic(world, id, dynRaw)
+	check(t, ok)
+	compare(t, string(raw), string([]byte{1, 2, 3}))
+
+	// Modify through reflection, then read the bytes back
+	val, ok := ReadDynamicValue(world, id, dynHealth)
+	check(t, ok)
+	val.FieldByName("Current").SetFloat(7)
+	data, _ = ReadDynamic(world, id, dynHealth)
+	compare(t, string(data), string(healthBytes(7, 10)))
+
+	// Moving archetypes keeps the dynamic data
+	world.Write(id, C(velocity{4, 5, 6}))
+	data, ok = ReadDynamic(world, id, dynHealth)
+	check(t, ok)
+	compare(t, string(data), string(healthBytes(7, 10)))
+	p, _ := Read[position](world, id)
+	compare(t, p, position{1, 2, 3})
+
+	DeleteComponent(world, id, dynRaw)
+	_, ok = ReadDynamic(world, id, dynRaw)
+	check(t, !ok)
+	data, ok = ReadDynamic(world, id, dynHealth)
+	check(t, ok)
+	compare(t, string(data), string(healthBytes(7, 10)))
+
+	// Writing the DynamicComp directly adds a zeroed component
+	world.Write(id, dynRaw)
+	raw, ok = ReadDynamic(world, id, dynRaw)
+	check(t, ok)
+	compare(t, string(raw), string([]byte{0, 0, 0}))
+}
+
+func TestDynamicQueryFilters(t *testing.T) {
+	world := NewWorld()
+
+	withHealth := world.Spawn(C(position{}), dynHealth.With(healthBytes(1, 1)))
+	world.Spawn(C(position{}))
+
+	count := 0
+	Query1[position](world, With(dynHealth)).MapId(func(id Id, pos *position) {
+		compare(t, id, withHealth)
+		count++
+	})
+	compare(t, count, 1)
+
+	compare(t, Query1[position](world, Without(dynHealth.CompId())).Count(), 1)
+}
+
+func TestDynamicCommandsAndEntities(t *testing.T) {
+	world := NewWorld()
+	cmd := NewCommandQueue(world)
+
+	ent := cmd.SpawnEmpty().
+		Insert(C(position{1, 1, 1})).
+		Insert(dynHealth.WithValue(reflect.ValueOf(struct {
+			Current float32
+			Max     float32
+		}{3, 4})))
+	cmd.Execute()
+
+	data, ok := ReadDynamic(world, ent.Id(), dynHealth)
+	check(t, ok)
+	compare(t, string(data), string(healthBytes(3, 4)))
+
+	// Round trip through an Entity
+	entity := ReadEntity(world, ent.Id())
+	data, ok = ReadDynamicFromEntity(entity, dynHealth)
+	check(t, ok)
+	compare(t, string(data), string(healthBytes(3, 4)))
+
+	id2 := world.NewId()
+	entity.Write(world, id2)
+	data, ok = ReadDynamic(world, id2, dynHealth)
+	check(t, ok)
+	compare(t, string(data), string(healthBytes(3, 4)))
+}
@@ -2,3 +2,10 @@ package ecs
 
 // This package provides ecs features.
 //go:generate go run ./internal/gen >> view_gen.go
+
+import "errors"
+
+var (
+	ErrNoMatch         = errors.New("ecs: no entities match the view")
+	ErrMultipleMatches = errors.New("ecs: more than one entity matches the view")
+)
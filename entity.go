@@ -72,7 +72,7 @@ func (e *Entity) Comps() []Component {
 // Reads a specific component from the entity, returns false if the component doesn't exist
 func ReadFromEntity[T any](ent *Entity) (T, bool) {
 	var t T
-	n := name(t)
+	n := compIdOf[T]()
 	idx := ent.findIndex(n)
 	if idx < 0 {
 		return t, false
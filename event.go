@@ -4,20 +4,12 @@ import "reflect"
 
 type EventId int
 
-var eventRegistryCounter EventId = 0
-var registeredEvents = make(map[reflect.Type]EventId, 0)
+var registeredEvents = newTypeRegistry[EventId](0, nil)
 
-// This function is not thread safe
+// Returns the EventId for the type T, registering it if it is new. Event ids are shared by every world.
+// This function is thread safe
 func NewEvent[T any]() EventId {
-	var t T
-	typeof := reflect.TypeOf(t)
-	eventId, ok := registeredEvents[typeof]
-	if !ok {
-		eventId = eventRegistryCounter
-		registeredEvents[typeof] = eventId
-		eventRegistryCounter++
-	}
-	return eventId
+	return registeredEvents.get(reflect.TypeFor[T]())
 }
 
 type Event interface {
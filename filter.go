@@ -1,21 +1,52 @@
 package ecs
 
 import (
+	"fmt"
 	"slices"
 )
 
 // Optional - Lets you view even if component is missing (func will return nil)
 // With - Lets you add additional components that must be present
 // Without - Lets you add additional components that must not be present
+// And, Or, Not, AnyOf - Let you combine filters into expressions, eg: And(With(sprite{}), Or(With(player{}), With(enemy{})), Not(With(dead{})))
+// Optional can only be passed to the query directly, it can't be nested in an expression
 type Filter interface {
 	Filter([]CompId) []CompId
 }
 
+// A filter that can be evaluated against an archetype mask, so that it can be nested in filter expressions
+type filterExpr interface {
+	Filter
+	matchesMask(archetypeMask) bool // Returns true if an archetype with the mask passes the filter
+	exprMask() archetypeMask        // Returns every component the filter refers to
+}
+
+// Converts the filter to an expression, panics if it can't be nested
+func toFilterExpr(f Filter) filterExpr {
+	if _, ok := f.(optional); ok {
+		panic("ecs: Optional can't be used inside of a filter expression, because it changes which components the view requires. Pass it to the query directly")
+	}
+	expr, ok := f.(filterExpr)
+	if !ok {
+		panic(fmt.Sprintf("ecs: filter %T can't be used inside of a filter expression", f))
+	}
+	return expr
+}
+
+func toFilterExprs(filters []Filter) []filterExpr {
+	exprs := make([]filterExpr, len(filters))
+	for i := range filters {
+		exprs[i] = toFilterExpr(filters[i])
+	}
+	return exprs
+}
+
 type without struct {
 	mask archetypeMask
 }
 
-// Creates a filter to ensure that entities will not have the specified components
+// Creates a filter to ensure that entities will not have the specified components.
+// Components can be specified by value (eg. position{}), or by anything with a CompId() method, like a DynamicComp or a CompId
 func Without(comps ...any) without {
 	return without{
 		mask: buildArchMaskFromAny(comps...),
@@ -25,16 +56,23 @@ func (w without) Filter(list []CompId) []CompId {
 	return list // Dont filter anything. We need to exclude later on
 	// return append(list, w.comps...)
 }
+func (w without) matchesMask(mask archetypeMask) bool {
+	return mask.bitwiseAnd(w.mask) == blankArchMask
+}
+func (w without) exprMask() archetypeMask {
+	return w.mask
+}
 
 type with struct {
 	comps []CompId
 }
 
-// Creates a filter to ensure that entities have the specified components
+// Creates a filter to ensure that entities have the specified components.
+// Components can be specified by value (eg. position{}), or by anything with a CompId() method, like a DynamicComp or a CompId
 func With(comps ...any) with {
 	ids := make([]CompId, len(comps))
 	for i := range comps {
-		ids[i] = name(comps[i])
+		ids[i] = compIdOfAny(comps[i])
 	}
 	return with{
 		comps: ids,
@@ -44,6 +82,12 @@ func With(comps ...any) with {
 func (w with) Filter(list []CompId) []CompId {
 	return append(list, w.comps...)
 }
+func (w with) matchesMask(mask archetypeMask) bool {
+	return w.exprMask().contains(mask)
+}
+func (w with) exprMask() archetypeMask {
+	return buildArchMaskFromId(w.comps...)
+}
 
 type optional struct {
 	comps []CompId
@@ -53,7 +97,7 @@ type optional struct {
 func Optional(comps ...any) optional {
 	ids := make([]CompId, len(comps))
 	for i := range comps {
-		ids[i] = name(comps[i])
+		ids[i] = compIdOfAny(comps[i])
 	}
 
 	return optional{
@@ -78,30 +122,200 @@ func (f optional) Filter(list []CompId) []CompId {
 	return list
 }
 
+type anyOf struct {
+	mask archetypeMask
+}
+
+// Creates a filter to ensure that entities have at least one of the specified components.
+// Components can be specified by value (eg. position{}), or by anything with a CompId() method, like a DynamicComp or a CompId
+func AnyOf(comps ...any) anyOf {
+	return anyOf{
+		mask: buildArchMaskFromAny(comps...),
+	}
+}
+func (f anyOf) Filter(list []CompId) []CompId {
+	return list // Evaluated against the archetype masks later on
+}
+func (f anyOf) matchesMask(mask archetypeMask) bool {
+	return mask.bitwiseAnd(f.mask) != blankArchMask
+}
+func (f anyOf) exprMask() archetypeMask {
+	return f.mask
+}
+
+type and struct {
+	filters []filterExpr
+}
+
+// Creates a filter that matches if every one of the filters matches. Filters can be nested, eg: And(With(a{}), Or(With(b{}), With(c{})))
+func And(filters ...Filter) and {
+	return and{
+		filters: toFilterExprs(filters),
+	}
+}
+func (f and) Filter(list []CompId) []CompId {
+	return list // Evaluated against the archetype masks later on
+}
+func (f and) matchesMask(mask archetypeMask) bool {
+	for _, expr := range f.filters {
+		if !expr.matchesMask(mask) {
+			return false
+		}
+	}
+	return true
+}
+func (f and) exprMask() archetypeMask {
+	var mask archetypeMask
+	for _, expr := range f.filters {
+		mask = mask.bitwiseOr(expr.exprMask())
+	}
+	return mask
+}
+
+type or struct {
+	filters []filterExpr
+}
+
+// Creates a filter that matches if at least one of the filters matches. Filters can be nested, eg: Or(With(a{}), And(With(b{}), Without(c{})))
+func Or(filters ...Filter) or {
+	return or{
+		filters: toFilterExprs(filters),
+	}
+}
+func (f or) Filter(list []CompId) []CompId {
+	return list // Evaluated against the archetype masks later on
+}
+func (f or) matchesMask(mask archetypeMask) bool {
+	for _, expr := range f.filters {
+		if expr.matchesMask(mask) {
+			return true
+		}
+	}
+	return false
+}
+func (f or) exprMask() archetypeMask {
+	var mask archetypeMask
+	for _, expr := range f.filters {
+		mask = mask.bitwiseOr(expr.exprMask())
+	}
+	return mask
+}
+
+type not struct {
+	filter filterExpr
+}
+
+// Creates a filter that matches if the filter doesn't match, eg: Not(With(a{}, b{})) matches entities that are missing either a or b
+func Not(filter Filter) not {
+	return not{
+		filter: toFilterExpr(filter),
+	}
+}
+func (f not) Filter(list []CompId) []CompId {
+	return list // Evaluated against the archetype masks later on
+}
+func (f not) matchesMask(mask archetypeMask) bool {
+	return !f.filter.matchesMask(mask)
+}
+func (f not) exprMask() archetypeMask {
+	return f.filter.exprMask()
+}
+
 type filterList struct {
 	comps                     []CompId
 	withoutArchMask           archetypeMask
-	cachedArchetypeGeneration int // Denotes the world's archetype generation that was used to create the list of archIds. If the world has a new generation, we should probably regenerate
+	sparse                    []CompId     // Sparse components that must be present. These aren't in the archetypes, so they are checked for every entity
+	withoutSparse             []CompId     // Sparse components that must not be present
+	exprs                     []filterExpr // Filter expressions that are evaluated against the archetype masks
+	cachedArchetypeGeneration int          // Denotes the world's archetype generation that was used to create the list of archIds. If the world has a new generation, we should probably regenerate
 	archIds                   []archetypeId
+	archSet                   []bool  // Indexed by archetypeId, true if the archetype is in archIds
+	gathered                  []idLoc // Reused between calls to gather
+	ordered                   []idLoc // Cached rows for orderedRows, see order.go
+	orderedBy                 Order   // The order that the cached rows are sorted by
+	orderedVersion            int     // The engine's layoutVersion when the cached rows were gathered
+}
+
+type idLoc struct {
+	id  Id
+	loc entLoc
 }
 
 func newFilterList(comps []CompId, filters ...Filter) filterList {
 	var withoutArchMask archetypeMask
+	var exprs []filterExpr
 	for _, f := range filters {
-		withoutFilter, isWithout := f.(without)
-		if isWithout {
-			withoutArchMask = withoutFilter.mask
-		} else {
+		switch f := f.(type) {
+		case without:
+			withoutArchMask = withoutArchMask.bitwiseOr(f.mask)
+		case and, or, not, anyOf:
+			// Sparse components aren't in the archetype masks, so the expression couldn't see them
+			expr := f.(filterExpr)
+			if expr.exprMask().bitwiseAnd(getSparseMask()) != blankArchMask {
+				panic("ecs: sparse components can't be used in filter expressions, only in With and Without")
+			}
+			exprs = append(exprs, expr)
+		default:
 			comps = f.Filter(comps)
 		}
 	}
 
+	// Split the sparse components out, because they can't be matched by archetype
+	var sparse []CompId
+	comps = slices.DeleteFunc(comps, func(compId CompId) bool {
+		if isSparse(compId) {
+			sparse = append(sparse, compId)
+			return true
+		}
+		return false
+	})
+	withoutSparse := withoutArchMask.bitwiseAnd(getSparseMask()).getComponentList()
+	withoutArchMask = withoutArchMask.withoutSparse()
+
 	return filterList{
 		comps:           comps,
 		withoutArchMask: withoutArchMask,
+		sparse:          sparse,
+		withoutSparse:   withoutSparse,
+		exprs:           exprs,
 		archIds:         make([]archetypeId, 0),
 	}
 }
+
+// Returns true if the filter list contains sparse components, which means that every entity needs to be checked with matchesSparse
+func (f *filterList) hasSparse() bool {
+	return len(f.sparse) > 0 || len(f.withoutSparse) > 0
+}
+
+// Returns true if the entity matches the sparse components of the filter list
+func (f *filterList) matchesSparse(e *archEngine, id Id) bool {
+	for _, compId := range f.sparse {
+		if !e.hasSparse(compId, id) {
+			return false
+		}
+	}
+	for _, compId := range f.withoutSparse {
+		if e.hasSparse(compId, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the number of entities in the archetype that match the filter list
+func (f *filterList) countArchetype(e *archEngine, lookup *lookupList) int {
+	if !f.hasSparse() {
+		return lookup.Len()
+	}
+
+	total := 0
+	for _, id := range lookup.id {
+		if id != InvalidEntity && f.matchesSparse(e, id) {
+			total++
+		}
+	}
+	return total
+}
 func (f *filterList) regenerate(world *World) {
 	if world.engine.getGeneration() != f.cachedArchetypeGeneration {
 		f.archIds = world.engine.FilterList(f.archIds, f.comps)
@@ -112,6 +326,97 @@ func (f *filterList) regenerate(world *World) {
 			})
 		}
 
+		if len(f.exprs) > 0 {
+			f.archIds = slices.DeleteFunc(f.archIds, func(archId archetypeId) bool {
+				mask := world.engine.dcr.revArchMask[archId]
+				for _, expr := range f.exprs {
+					if !expr.matchesMask(mask) {
+						return true
+					}
+				}
+				return false
+			})
+		}
+
+		clear(f.archSet)
+		f.archSet = slices.Grow(f.archSet[:0], len(world.engine.lookup))[:len(world.engine.lookup)]
+		for _, archId := range f.archIds {
+			f.archSet[archId] = true
+		}
+
 		f.cachedArchetypeGeneration = world.engine.getGeneration()
 	}
 }
+
+// Returns the first entity that matches the filter list, in iteration order
+func (f *filterList) first(world *World) (Id, entLoc, bool) {
+	f.regenerate(world)
+	sparse := f.hasSparse()
+	for _, archId := range f.archIds {
+		lookup := world.engine.lookup[archId]
+		for idx, id := range lookup.id {
+			if id == InvalidEntity {
+				continue
+			}
+			if sparse && !f.matchesSparse(world.engine, id) {
+				continue
+			}
+			return id, entLoc{archId, uint32(idx)}, true
+		}
+	}
+	return InvalidEntity, entLoc{}, false
+}
+
+// Looks up the location of every id that matches the filter list, and sorts them by archetype and then by index so that they can be visited in storage order. Repeated ids are only returned once.
+// The returned slice must be handed back with doneGathering once it isn't needed anymore
+func (f *filterList) gather(world *World, ids []Id) []idLoc {
+	f.regenerate(world)
+	sparse := f.hasSparse()
+
+	// Note: The buffer is taken while it is in use, so that nested calls get their own
+	gathered := f.gathered[:0]
+	f.gathered = nil
+	for _, id := range ids {
+		loc, ok := world.arch.Get(id)
+		if !ok || !f.archSet[loc.archId] {
+			continue
+		}
+		if sparse && !f.matchesSparse(world.engine, id) {
+			continue
+		}
+		gathered = append(gathered, idLoc{id, loc})
+	}
+
+	slices.SortFunc(gathered, func(a, b idLoc) int {
+		if a.loc.archId != b.loc.archId {
+			return int(a.loc.archId) - int(b.loc.archId)
+		}
+		return int(a.loc.index) - int(b.loc.index)
+	})
+
+	// Repeated ids have the same location, so they are next to each other after sorting
+	return slices.CompactFunc(gathered, func(a, b idLoc) bool {
+		return a.id == b.id
+	})
+}
+
+func (f *filterList) doneGathering(gathered []idLoc) {
+	f.gathered = gathered
+}
+
+// Returns the location of the entity if it exists and matches the filter list
+func (f *filterList) matches(world *World, id Id) (entLoc, bool) {
+	loc, ok := world.arch.Get(id)
+	if !ok {
+		return entLoc{}, false
+	}
+
+	f.regenerate(world)
+	if !f.archSet[loc.archId] {
+		return entLoc{}, false
+	}
+	if f.hasSparse() && !f.matchesSparse(world.engine, id) {
+		return entLoc{}, false
+	}
+	return loc, true
+}
@@ -0,0 +1,68 @@
+package ecs
+
+// The archetype graph caches the transitions between archetypes. Every archetype stores an edge for each component that has been added to or removed from it.
+// Following an edge skips hashing the whole archetypeMask, and the edge also stores the list of storages that need to be copied when an entity moves.
+// Only single component transitions are cached, because they are by far the most common. Anything else falls back to the archetype mask lookup.
+
+// An edge from one archetype to another
+type archEdge struct {
+	compId CompId      // The component that was added or removed
+	archId archetypeId // The archetype at the other end of the edge
+	move   []storage   // The storages of every component that both archetypes have, these get copied when an entity moves
+}
+
+// Returns the edge from the archetype to the archetype of newMask. Returns false if the masks differ by more than one component, in which case nothing is cached
+func (e *archEngine) getEdge(archId archetypeId, newMask archetypeMask) (archEdge, bool) {
+	lookup := e.lookup[archId]
+	added := newMask.bitwiseClear(lookup.mask)
+	removed := lookup.mask.bitwiseClear(newMask)
+
+	var edges *[]archEdge
+	var compId CompId
+	var single bool
+	if removed == blankArchMask {
+		compId, single = added.singleComponent()
+		edges = &lookup.addEdges
+	} else if added == blankArchMask {
+		compId, single = removed.singleComponent()
+		edges = &lookup.removeEdges
+	}
+	if !single {
+		return archEdge{}, false
+	}
+
+	edge, ok := findEdge(*edges, compId)
+	if !ok {
+		edge = e.buildEdge(lookup, compId, newMask)
+		*edges = append(*edges, edge)
+	}
+	return edge, true
+}
+
+// Note: Archetypes usually only have a handful of edges, so a linear search is faster than a map
+func findEdge(edges []archEdge, compId CompId) (archEdge, bool) {
+	for i := range edges {
+		if edges[i].compId == compId {
+			return edges[i], true
+		}
+	}
+	return archEdge{}, false
+}
+
+func (e *archEngine) buildEdge(oldLookup *lookupList, compId CompId, newMask archetypeMask) archEdge {
+	newArchId := e.getArchetypeId(newMask)
+	newLookup := e.lookup[newArchId]
+
+	move := make([]storage, 0, min(len(oldLookup.components), len(newLookup.components)))
+	for _, compId := range oldLookup.components {
+		if newLookup.mask.hasComponent(compId) {
+			move = append(move, e.getStorage(compId))
+		}
+	}
+
+	return archEdge{
+		compId: compId,
+		archId: newArchId,
+		move:   move,
+	}
+}
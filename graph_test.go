@@ -0,0 +1,79 @@
+package ecs
+
+import "testing"
+
+func TestArchetypeEdges(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), C(velocity{2, 2, 2}))
+	b := world.Spawn(C(position{3, 3, 3}), C(velocity{4, 4, 4}))
+
+	start, _ := world.arch.Get(a)
+	world.Write(a, C(radius{5}))
+	moved, _ := world.arch.Get(a)
+
+	// The edge is cached on the source archetype, and both directions lead to the same archetypes
+	lookup := world.engine.lookup[start.archId]
+	edge, ok := findEdge(lookup.addEdges, radiusId.CompId())
+	check(t, ok)
+	compare(t, edge.archId, moved.archId)
+	compare(t, len(edge.move), 2)
+
+	world.Write(b, C(radius{6}))
+	loc, _ := world.arch.Get(b)
+	compare(t, loc.archId, moved.archId)
+
+	DeleteComponent(world, b, C(radius{}))
+	loc, _ = world.arch.Get(b)
+	compare(t, loc.archId, start.archId)
+	edge, ok = findEdge(world.engine.lookup[moved.archId].removeEdges, radiusId.CompId())
+	check(t, ok)
+	compare(t, edge.archId, start.archId)
+
+	p, _ := Read[position](world, b)
+	compare(t, p, position{3, 3, 3})
+	v, _ := Read[velocity](world, b)
+	compare(t, v, velocity{4, 4, 4})
+	r, _ := Read[radius](world, a)
+	compare(t, r, radius{5})
+
+	// Multi component changes don't create edges
+	world.Write(b, C(radius{}), C(acceleration{}))
+	_, ok = findEdge(lookup.addEdges, compIdOf[acceleration]())
+	check(t, !ok)
+	a2, ok := Read[acceleration](world, b)
+	check(t, ok)
+	compare(t, a2, acceleration{})
+}
+
+func TestMaskSingleComponent(t *testing.T) {
+	_, ok := blankArchMask.singleComponent()
+	check(t, !ok)
+
+	compId, ok := buildArchMaskFromId(130).singleComponent()
+	check(t, ok)
+	compare(t, compId, 130)
+
+	_, ok = buildArchMaskFromId(3, 130).singleComponent()
+	check(t, !ok)
+	_, ok = buildArchMaskFromId(3, 4).singleComponent()
+	check(t, !ok)
+}
+
+func BenchmarkToggleComponent(b *testing.B) {
+	world := NewWorld()
+	ids := make([]Id, 1000)
+	for i := range ids {
+		ids[i] = world.Spawn(C(position{}), C(velocity{}), C(acceleration{}))
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, id := range ids {
+			world.Write(id, C(radius{}))
+		}
+		for _, id := range ids {
+			DeleteComponent(world, id, C(radius{}))
+		}
+		world.CleanupHoles()
+	}
+}
@@ -40,6 +40,14 @@ func (m *internalMap[K, V]) Has(k K) bool {
 	return has
 }
 
+func (m *internalMap[K, V]) Clear() {
+	m.inner.Clear()
+}
+
+func (m *internalMap[K, V]) ForEach(f func(K, V)) {
+	m.inner.ForEach(f)
+}
+
 //--------------------------------------------------------------------------------
 // TODO: Move to generational Ids
 
@@ -76,6 +84,11 @@ func (m *locMap) Has(k Id) bool {
 	return has
 }
 
+// Removes everything from the map, but keeps the memory for reuse
+func (m *locMap) Clear() {
+	m.inner.Clear()
+}
+
 // --------------------------------------------------------------------------------
 // const fillFactor64 = 0.5
 
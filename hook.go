@@ -19,9 +19,24 @@ func (e *archEngine) runFinalizedHooks(id Id) {
 	}
 	e.finalizeOnAdd = e.finalizeOnAdd[:0]
 
+	e.flushReactive()
+
 	// TODO: Run other hooks?
 }
 
+// Runs the add hooks of every component in the mask for each of the ids. This is used for batches, where every entity was spawned with the same components
+func (e *archEngine) runBatchHooks(ids []Id, mask archetypeMask) {
+	e.finalizeOnAdd = markComponentMask(e.finalizeOnAdd[:0], mask)
+	for _, id := range ids {
+		for i := range e.finalizeOnAdd {
+			e.runAddHook(id, e.finalizeOnAdd[i])
+		}
+	}
+	e.finalizeOnAdd = e.finalizeOnAdd[:0]
+
+	e.flushReactive()
+}
+
 func (e *archEngine) runAddHook(id Id, compId CompId) {
 	current := e.onAddHooks[compId]
 	if current == nil {
@@ -32,9 +47,14 @@ func (e *archEngine) runAddHook(id Id, compId CompId) {
 }
 
 // Marks all provided components
+// Note: Sparse components are skipped because they mark themselves when they are written, if they were actually added
 func markComponents(slice []CompId, comp ...Component) []CompId {
 	for i := range comp {
-		slice = append(slice, comp[i].CompId())
+		compId := comp[i].CompId()
+		if isSparse(compId) {
+			continue
+		}
+		slice = append(slice, compId)
 	}
 	return slice
 }
@@ -46,6 +66,9 @@ func markNewComponents(slice []CompId, oldMask archetypeMask, comp ...Component)
 		if oldMask.hasComponent(compId) {
 			continue // Skip: Component already set in oldMask
 		}
+		if isSparse(compId) {
+			continue
+		}
 
 		slice = append(slice, compId)
 	}
@@ -53,14 +76,7 @@ func markNewComponents(slice []CompId, oldMask archetypeMask, comp ...Component)
 }
 
 func markComponentMask(slice []CompId, mask archetypeMask) []CompId {
-	// TODO: Optimization: Technically this only has to loop to the max registered compId, not the max possible. Also see optimization note in archEngine
-	for compId := CompId(0); compId <= maxComponentId; compId++ {
-		if mask.hasComponent(compId) {
-			slice = append(slice, compId)
-		}
-	}
-
-	return slice
+	return mask.appendComponents(slice)
 }
 
 func markComponentDiff(slice []CompId, newMask, oldMask archetypeMask) []CompId {
@@ -13,6 +13,9 @@ import (
 //go:embed view.tgo
 var viewTemplate string
 
+//go:embed bundle.tgo
+var bundleTemplate string
+
 type viewData struct {
 	Views [][]string
 }
@@ -61,6 +64,20 @@ func main() {
 			}
 			return strings.Join(ret, ", ")
 		},
+		"valueArgs": func(val []string) string {
+			ret := make([]string, len(val))
+			for i := range val {
+				ret[i] = strings.ToLower(val[i]) + " " + val[i]
+			}
+			return strings.Join(ret, ", ")
+		},
+		"valueList": func(val []string) string {
+			ret := make([]string, len(val))
+			for i := range val {
+				ret[i] = strings.ToLower(val[i])
+			}
+			return strings.Join(ret, ", ")
+		},
 		"sliceLambdaArgs": func(val []string) string {
 			ret := make([]string, len(val))
 			for i := range val {
@@ -84,14 +101,17 @@ func main() {
 		},
 	}
 
-	t := template.Must(template.New("ViewTemplate").Funcs(funcs).Parse(viewTemplate))
+	generate("view_gen.go", viewTemplate, funcs, data)
+	generate("bundle_gen.go", bundleTemplate, funcs, data)
+}
+
+func generate(filename string, tmpl string, funcs template.FuncMap, data viewData) {
+	t := template.Must(template.New(filename).Funcs(funcs).Parse(tmpl))
 
 	buf := bytes.NewBuffer([]byte{})
 
 	t.Execute(buf, data)
 
-	filename := "view_gen.go"
-
 	// Attempt to write the file as formatted, falling back to writing it normally
 	formatted, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -1,20 +1,29 @@
 package ecs
 
-import "fmt"
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
 
-// Note: you can increase max component size by increasing maxComponentId and archetypeMask
-// TODO: I should have some kind of panic if you go over maximum component size
-const numMaskBlocks = 4
-const maxComponentId = (numMaskBlocks * 64) - 1 // 4 maskBlocks = 255 components
+// Note: numMaskBlocks is set by build tags (see mask_size*.go). Registering more component types than this panics
+const maxComponentId = (numMaskBlocks * 64) - 1 // Note: componentId 0 is invalid, so we support one less than the mask size
 
 var blankArchMask archetypeMask
 
-// Supports maximum 256 unique component types
-type archetypeMask [numMaskBlocks]uint64 // TODO: can/should I make this configurable?
+// Supports maximum numMaskBlocks * 64 unique component types
+type archetypeMask [numMaskBlocks]uint64
+
 func (a archetypeMask) String() string {
-	return fmt.Sprintf("0x%x%x%x%x", a[0], a[1], a[2], a[3])
+	var sb strings.Builder
+	sb.WriteString("0x")
+	for i := range a {
+		fmt.Fprintf(&sb, "%x", a[i])
+	}
+	return sb.String()
 }
 
+// Builds the archetype mask for the components. Sparse components are excluded because they aren't stored in archetypes
 func buildArchMask(comps ...Component) archetypeMask {
 	var mask archetypeMask
 	for _, comp := range comps {
@@ -24,13 +33,13 @@ func buildArchMask(comps ...Component) archetypeMask {
 		offset := c - (64 * idx)
 		mask[idx] |= (1 << offset)
 	}
-	return mask
+	return mask.withoutSparse()
 }
 func buildArchMaskFromAny(comps ...any) archetypeMask {
 	var mask archetypeMask
 	for _, comp := range comps {
 		// Ranges: [0, 64), [64, 128), [128, 192), [192, 256)
-		c := name(comp)
+		c := compIdOfAny(comp)
 		idx := c / 64
 		offset := c - (64 * idx)
 		mask[idx] |= (1 << offset)
@@ -118,11 +127,34 @@ func (m archetypeMask) hasComponent(compId CompId) bool {
 
 // Generates and returns a list of every componentId that this archetype contains
 func (m archetypeMask) getComponentList() []CompId {
-	ret := make([]CompId, 0)
-	for compId := CompId(0); compId <= maxComponentId; compId++ {
-		if m.hasComponent(compId) {
-			ret = append(ret, compId)
+	return m.appendComponents(make([]CompId, 0))
+}
+
+// Returns the component if it is the only one set in the mask, else returns false
+func (m archetypeMask) singleComponent() (CompId, bool) {
+	found := -1
+	for i := range m {
+		if m[i] == 0 {
+			continue
+		}
+		if found >= 0 || m[i]&(m[i]-1) != 0 {
+			return 0, false // More than one bit is set
+		}
+		found = i*64 + bits.TrailingZeros64(m[i])
+	}
+	return CompId(found), found >= 0
+}
+
+// Appends every componentId that this mask contains to the slice, in increasing order.
+// This only visits the set bits, so it is cheap for sparse masks
+func (m archetypeMask) appendComponents(slice []CompId) []CompId {
+	for i := range m {
+		block := m[i]
+		for block != 0 {
+			offset := bits.TrailingZeros64(block)
+			slice = append(slice, CompId(i*64+offset))
+			block &= block - 1 // Clear the lowest set bit
 		}
 	}
-	return ret
+	return slice
 }
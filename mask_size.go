@@ -0,0 +1,7 @@
+//go:build !ecs_components512 && !ecs_components1024
+
+package ecs
+
+// The number of 64 bit blocks in an archetypeMask, which supports 255 component types.
+// If you need more, build with the ecs_components512 or ecs_components1024 tags.
+const numMaskBlocks = 4
@@ -0,0 +1,6 @@
+//go:build ecs_components512 && !ecs_components1024
+
+package ecs
+
+// The number of 64 bit blocks in an archetypeMask, which supports 511 component types.
+const numMaskBlocks = 8
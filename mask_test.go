@@ -258,3 +258,25 @@ func TestRemoveCompMask(t *testing.T) {
 		}
 	}
 }
+
+func TestGetComponentList(t *testing.T) {
+	tests := [][]CompId{
+		{},
+		{0},
+		{0, 1, 2},
+		{5, 63, 64, 127, 128, 200, maxComponentId},
+	}
+
+	for _, test := range tests {
+		got := buildArchMaskFromId(test...).getComponentList()
+		if len(got) != len(test) {
+			t.Errorf("error: %v != %v", got, test)
+			continue
+		}
+		for i := range got {
+			if got[i] != test[i] {
+				t.Errorf("error: %v != %v", got, test)
+			}
+		}
+	}
+}
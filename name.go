@@ -3,71 +3,79 @@ package ecs
 import (
 	"fmt"
 	"reflect"
-	"sync"
+	"sync/atomic"
 )
 
 func nameTyped[T any](comp T) CompId {
-	compId := name(comp)
+	compId := compIdOf[T]()
 	registerComponentStorage[T](compId)
 	return compId
 }
 
 type storageBuilder interface {
-	build() storage
+	build(compId CompId, allocation int) storage
 }
 type storageBuilderImp[T any] struct {
 }
 
-func (s storageBuilderImp[T]) build() storage {
-	return &componentStorage[T]{
-		slice: newMap[archetypeId, *componentList[T]](DefaultAllocation),
+func (s storageBuilderImp[T]) build(compId CompId, allocation int) storage {
+	ss := &componentStorage[T]{
+		slice:      newMap[archetypeId, *componentList[T]](allocation),
+		allocation: allocation,
+		compId:     compId,
 	}
+	if isSparse(compId) {
+		ss.sparse = newSparseSet[T](allocation)
+	} else if isTag(compId) {
+		ss.tag = newTagList[T]()
+	}
+	return ss
 }
 
-var componentStorageLookupMut sync.RWMutex
-var componentStorageLookup = make(map[CompId]storageBuilder)
+// Indexed by componentId. Builders are only ever set once, so they can be read without locking
+var componentStorageLookup [maxComponentId + 1]atomic.Pointer[storageBuilder]
 
 func registerComponentStorage[T any](compId CompId) {
-	componentStorageLookupMut.Lock()
-	_, ok := componentStorageLookup[compId]
-	if !ok {
-		componentStorageLookup[compId] = storageBuilderImp[T]{}
+	if componentStorageLookup[compId].Load() != nil {
+		return // Already registered
+	}
+
+	if reflect.TypeFor[T]().Size() == 0 {
+		tagComponents.add(compId)
 	}
-	componentStorageLookupMut.Unlock()
+
+	var builder storageBuilder = storageBuilderImp[T]{}
+	componentStorageLookup[compId].CompareAndSwap(nil, &builder)
 }
 
-func newComponentStorage(c CompId) storage {
-	componentStorageLookupMut.RLock()
-	s, ok := componentStorageLookup[c]
-	if !ok {
+func newComponentStorage(c CompId, allocation int) storage {
+	s := componentStorageLookup[c].Load()
+	if s == nil {
 		panic(fmt.Sprintf("tried to build component storage with unregistered componentId: %d", c))
 	}
-
-	componentStorageLookupMut.RUnlock()
-	return s.build()
+	return (*s).build(c, allocation)
 }
 
 //--------------------------------------------------------------------------------
 
-var componentIdMutex sync.Mutex
-var registeredComponents = make(map[reflect.Type]CompId, maxComponentId)
 var invalidComponentId CompId = 0
-var componentRegistryCounter CompId = 1
+var registeredComponents = newTypeRegistry(CompId(1), validateComponentId)
 
-func name(t any) CompId {
-	// Note: We have to lock here in case there are multiple worlds
-	// TODO!! - This probably causes some performance penalty
-	componentIdMutex.Lock()
-	defer componentIdMutex.Unlock()
-
-	typeof := reflect.TypeOf(t)
-	compId, ok := registeredComponents[typeof]
-	if !ok {
-		compId = componentRegistryCounter
-		registeredComponents[typeof] = compId
-		componentRegistryCounter++
+// Panics if the id doesn't fit in an archetypeMask. The mask size is fixed at compile time by build tags, it never grows at runtime
+func validateComponentId(name string, compId CompId) {
+	if compId > maxComponentId {
+		panic(fmt.Sprintf("ecs: can't register component %s, the maximum of %d component types has been reached. Build with the ecs_components512 or ecs_components1024 tags to support more", name, maxComponentId))
 	}
-	return compId
+}
+
+func name(t any) CompId {
+	return registeredComponents.get(reflect.TypeOf(t))
+}
+
+// Returns the componentId of the type T. Prefer this over name() in generic code, because it doesn't need a value of T.
+// This is a lock free map lookup, so it doesn't contend between goroutines (see BenchmarkReadParallel). Views, bundles and component storages look it up once and keep the id, so only the free functions like Read pay for it on every call
+func compIdOf[T any]() CompId {
+	return registeredComponents.get(reflect.TypeFor[T]())
 }
 
 // // Possible solution: Runs faster than reflection (mostly useful for potentially removing/reducing ecs.C(...) overhead
@@ -16,3 +16,79 @@ func BenchmarkName(b *testing.B) {
 		C(radius{})
 	}
 }
+
+func BenchmarkCompIdOf(b *testing.B) {
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		compIdOf[position]()
+		compIdOf[velocity]()
+		compIdOf[acceleration]()
+		compIdOf[radius]()
+	}
+}
+
+func BenchmarkCompIdOfParallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			compIdOf[position]()
+			compIdOf[velocity]()
+		}
+	})
+}
+
+// Read looks up the id of T on every call, this makes sure that the lookup doesn't contend between goroutines
+func BenchmarkReadParallel(b *testing.B) {
+	world := NewWorld()
+	ids := make([]Id, 1000)
+	for i := range ids {
+		ids[i] = world.NewId()
+		Write(world, ids[i], C(position{}), C(velocity{}))
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			Read[position](world, ids[i%len(ids)])
+			Read[velocity](world, ids[i%len(ids)])
+			i++
+		}
+	})
+}
+
+func TestCompIdOf(t *testing.T) {
+	compare(t, compIdOf[position](), name(position{}))
+	compare(t, compIdOf[velocity](), velocityId.CompId())
+	check(t, compIdOf[position]() != compIdOf[velocity]())
+
+	world := NewWorld()
+	compare(t, getStorage[position](world.engine).compId, compIdOf[position]())
+	compare(t, getStorage[stunned](world.engine).compId, stunnedId.CompId())
+}
+
+func TestCompIdConcurrentRegistration(t *testing.T) {
+	type compA struct{ a int }
+	type compB struct{ b int }
+	type compC struct{ c int }
+
+	const workers = 8
+	results := make([][3]CompId, workers)
+	done := make(chan struct{})
+	for i := range workers {
+		go func() {
+			results[i] = [3]CompId{compIdOf[compA](), compIdOf[compB](), compIdOf[compC]()}
+			done <- struct{}{}
+		}()
+	}
+	for range workers {
+		<-done
+	}
+
+	for i := range results {
+		compare(t, results[i], results[0])
+	}
+	compare(t, results[0][0], name(compA{}))
+	compare(t, results[0][1], name(compB{}))
+	compare(t, results[0][2], name(compC{}))
+}
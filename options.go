@@ -0,0 +1,22 @@
+package ecs
+
+// Configures a World, see NewWorldWithOptions. This lets worlds in the same process be tuned differently
+type WorldOptions struct {
+	Allocation int // The initial capacity of each archetype and component list
+
+	// The range of Ids returned by NewId, useful when you have multiple worlds and don't want their Ids to collide. Zero means the full range
+	MinId, MaxId Id
+
+	Compaction CompactionPolicy // Controls when holes are automatically compacted, see CompactionPolicy
+
+	// Enables extra checks that panic on misuse, such as compacting or resetting the world while a view is being mapped, or writing to InvalidEntity.
+	// These checks have a small cost, so you probably only want them during development
+	DebugChecks bool
+}
+
+// Returns the options used by NewWorld
+func DefaultWorldOptions() WorldOptions {
+	return WorldOptions{
+		Allocation: DefaultAllocation,
+	}
+}
@@ -0,0 +1,122 @@
+package ecs
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Describes the order that MapIdOrdered visits entities in. Iteration order of MapId depends on the order that archetypes were created in, so use this if you need the same order everywhere (eg. for lockstep simulations)
+type Order interface {
+	sort(world *World, rows []idLoc)
+	dynamic() bool // True if the order can change without any entities moving, so it needs to be sorted every time
+}
+
+type byId struct{}
+
+// Orders entities by their Id, from smallest to largest
+func ById() Order {
+	return byId{}
+}
+
+func (o byId) sort(world *World, rows []idLoc) {
+	slices.SortFunc(rows, func(a, b idLoc) int {
+		return cmp.Compare(a.id, b.id)
+	})
+}
+
+func (o byId) dynamic() bool {
+	return false
+}
+
+type byKey[T any, K cmp.Ordered] struct {
+	key   func(*T) K
+	keyed []keyedRow[K] // Reused between sorts
+}
+
+type keyedRow[K cmp.Ordered] struct {
+	has bool
+	key K
+	row idLoc
+}
+
+// Orders entities by a key that is read from their component T, from smallest to largest. Entities with the same key are ordered by their Id, and entities without the component come first.
+// Keys are read again every time the view is mapped, because components can change without the entity moving
+func ByKey[T any, K cmp.Ordered](key func(*T) K) Order {
+	return &byKey[T, K]{
+		key: key,
+	}
+}
+
+func (o *byKey[T, K]) sort(world *World, rows []idLoc) {
+	store := getStorage[T](world.engine)
+
+	o.keyed = o.keyed[:0]
+	for _, row := range rows {
+		keyed := keyedRow[K]{row: row}
+		if val := store.get(row.loc, row.id); val != nil {
+			keyed.has = true
+			keyed.key = o.key(val)
+		}
+		o.keyed = append(o.keyed, keyed)
+	}
+
+	// Note: Rows are usually still sorted from the last time, which the sort is fast for
+	slices.SortFunc(o.keyed, func(a, b keyedRow[K]) int {
+		if a.has != b.has {
+			if a.has {
+				return 1
+			}
+			return -1
+		}
+		if c := cmp.Compare(a.key, b.key); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.row.id, b.row.id)
+	})
+
+	for i := range o.keyed {
+		rows[i] = o.keyed[i].row
+	}
+}
+
+func (o *byKey[T, K]) dynamic() bool {
+	return true
+}
+
+// Returns every entity that matches the filter list, sorted by the order, along with the engine's layoutVersion that they were gathered at.
+// The rows are cached, so if no entities have been added, removed or moved since the last call then they don't need to be gathered or sorted again.
+// The returned slice must be handed back with doneOrdering once it isn't needed anymore
+func (f *filterList) orderedRows(world *World, order Order) ([]idLoc, int) {
+	f.regenerate(world)
+
+	// Note: The cache is taken while it is in use, so that nested calls can't reorder it
+	rows, orderedBy, version := f.ordered, f.orderedBy, f.orderedVersion
+	f.ordered, f.orderedBy, f.orderedVersion = nil, nil, 0
+
+	if version != world.engine.layoutVersion {
+		rows = rows[:0]
+		for _, archId := range f.archIds {
+			lookup := world.engine.lookup[archId]
+			for idx, id := range lookup.id {
+				if id == InvalidEntity {
+					continue
+				}
+				if f.hasSparse() && !f.matchesSparse(world.engine, id) {
+					continue
+				}
+				rows = append(rows, idLoc{id, entLoc{archId, uint32(idx)}})
+			}
+		}
+		orderedBy = nil
+	}
+
+	if orderedBy != order || order.dynamic() {
+		order.sort(world, rows)
+	}
+	return rows, world.engine.layoutVersion
+}
+
+// Hands the rows back to be cached. The version must be the one returned by orderedRows, so that changes made while the rows were in use invalidate them
+func (f *filterList) doneOrdering(rows []idLoc, order Order, version int) {
+	f.ordered, f.orderedBy, f.orderedVersion = rows, order, version
+}
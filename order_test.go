@@ -0,0 +1,103 @@
+package ecs
+
+import (
+	"slices"
+	"testing"
+)
+
+func orderedIds(query *View1[position], order Order) []Id {
+	ids := make([]Id, 0)
+	query.MapIdOrdered(order, func(id Id, p *position) {
+		ids = append(ids, id)
+	})
+	return ids
+}
+
+func TestMapIdOrderedById(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 0)
+	for i := 0; i < 20; i++ {
+		// Spread the entities across archetypes, so that MapId wouldn't visit them in Id order
+		switch i % 3 {
+		case 0:
+			ids = append(ids, world.Spawn(C(position{}), C(radius{})))
+		case 1:
+			ids = append(ids, world.Spawn(C(position{}), C(velocity{})))
+		case 2:
+			ids = append(ids, world.Spawn(C(position{})))
+		}
+	}
+
+	query := Query1[position](world)
+	check(t, slices.Equal(orderedIds(query, ById()), ids))
+	check(t, slices.Equal(orderedIds(query, ById()), ids)) // Cached
+
+	// Structural changes invalidate the cache
+	Delete(world, ids[5])
+	world.Write(ids[7], C(acceleration{}))
+	ids = append(slices.Delete(ids, 5, 6), world.Spawn(C(position{}), C(stunned{})))
+	check(t, slices.Equal(orderedIds(query, ById()), ids))
+
+	world.Cmd().Execute()
+	world.CleanupHoles()
+	check(t, slices.Equal(orderedIds(query, ById()), ids))
+}
+
+func TestMapIdOrderedByKey(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{3, 0, 0}))
+	b := world.Spawn(C(position{1, 0, 0}), C(velocity{}))
+	c := world.Spawn(C(position{2, 0, 0}))
+	d := world.Spawn(C(position{1, 0, 0}))
+
+	query := Query1[position](world)
+	byX := ByKey(func(p *position) float64 { return p.x })
+	check(t, slices.Equal(orderedIds(query, byX), []Id{b, d, c, a})) // Ties are ordered by Id
+
+	// Keys are read again, even though nothing moved
+	query.MapId(func(id Id, p *position) {
+		p.x = -p.x
+	})
+	check(t, slices.Equal(orderedIds(query, byX), []Id{a, c, b, d}))
+	check(t, slices.Equal(orderedIds(query, ById()), []Id{a, b, c, d}))
+
+	// Entities without the key component come first
+	byRadiusQuery := Query1[position](world)
+	byRadius := ByKey(func(r *radius) float64 { return r.r })
+	world.Write(a, C(radius{2}))
+	world.Write(c, C(radius{1}))
+	check(t, slices.Equal(orderedIds(byRadiusQuery, byRadius), []Id{b, d, c, a}))
+}
+
+func TestMapIdOrderedCacheVersion(t *testing.T) {
+	world := NewWorld()
+	ids := SpawnBatch(world, 10, C(position{}))
+	query := Query1[position](world, Without(stunned{}))
+	check(t, slices.Equal(orderedIds(query, ById()), ids))
+
+	// Nothing changed, so the cached rows are reused without being checked
+	version := query.filter.orderedVersion
+	compare(t, version, world.engine.layoutVersion)
+	world.Write(ids[0], C(position{1, 1, 1}))
+	compare(t, world.engine.layoutVersion, version)
+	check(t, slices.Equal(orderedIds(query, ById()), ids))
+
+	// Adding and removing sparse components doesn't move the entity, but it still invalidates the cache
+	world.Write(ids[3], C(stunned{}))
+	check(t, world.engine.layoutVersion != version)
+	check(t, slices.Equal(orderedIds(query, ById()), slices.Delete(slices.Clone(ids), 3, 4)))
+	DeleteComponent(world, ids[3], C(stunned{}))
+	check(t, slices.Equal(orderedIds(query, ById()), ids))
+
+	// Changes made inside of the lambda invalidate the rows that are being used
+	query.MapIdOrdered(ById(), func(id Id, p *position) {
+		if id == ids[0] {
+			Delete(world, ids[9])
+		}
+	})
+	check(t, slices.Equal(orderedIds(query, ById()), ids[:9]))
+
+	Delete(world, ids[1])
+	world.CleanupHoles()
+	check(t, slices.Equal(orderedIds(query, ById()), slices.Delete(slices.Clone(ids[:9]), 1, 2)))
+}
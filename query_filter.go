@@ -0,0 +1,93 @@
+package ecs
+
+// A set of filters that is described by a type, so that it can be part of a view's type, eg: *View2F[position, velocity, Lacks[frozen]]
+// This lets views that are injected into systems be filtered, because the filters can be built from the zero value of the type.
+// Each filter has a type level version: Has is With, Lacks is Without, Maybe is Optional, HasAnyN is AnyOf, Either is Or and Negate is Not.
+// FiltersN only combines up to four filters, but they can be nested if you need more, eg: Filters2[Filters4[...], Lacks[dead]]
+type QueryFilter interface {
+	Filters() []Filter
+}
+
+// A QueryFilter that ensures that entities have the component T. This is the type level version of With
+type Has[T any] struct{}
+
+func (Has[T]) Filters() []Filter {
+	return []Filter{With(compIdOf[T]())}
+}
+
+// A QueryFilter that ensures that entities don't have the component T. This is the type level version of Without
+type Lacks[T any] struct{}
+
+func (Lacks[T]) Filters() []Filter {
+	return []Filter{Without(compIdOf[T]())}
+}
+
+// A QueryFilter that lets the view iterate even if the component T is missing. This is the type level version of Optional
+type Maybe[T any] struct{}
+
+func (Maybe[T]) Filters() []Filter {
+	return []Filter{Optional(compIdOf[T]())}
+}
+
+// Combines two QueryFilters, eg: Filters2[Has[enemy], Lacks[frozen]]
+type Filters2[A, B QueryFilter] struct{}
+
+func (Filters2[A, B]) Filters() []Filter {
+	var a A
+	var b B
+	return append(a.Filters(), b.Filters()...)
+}
+
+// Combines three QueryFilters
+type Filters3[A, B, C QueryFilter] struct{}
+
+func (Filters3[A, B, C]) Filters() []Filter {
+	var c C
+	return append(Filters2[A, B]{}.Filters(), c.Filters()...)
+}
+
+// Combines four QueryFilters
+type Filters4[A, B, C, D QueryFilter] struct{}
+
+func (Filters4[A, B, C, D]) Filters() []Filter {
+	var d D
+	return append(Filters3[A, B, C]{}.Filters(), d.Filters()...)
+}
+
+// A QueryFilter that ensures that entities have at least one of the components. This is the type level version of AnyOf
+type HasAny2[A, B any] struct{}
+
+func (HasAny2[A, B]) Filters() []Filter {
+	return []Filter{AnyOf(compIdOf[A](), compIdOf[B]())}
+}
+
+// A QueryFilter that ensures that entities have at least one of the components
+type HasAny3[A, B, C any] struct{}
+
+func (HasAny3[A, B, C]) Filters() []Filter {
+	return []Filter{AnyOf(compIdOf[A](), compIdOf[B](), compIdOf[C]())}
+}
+
+// A QueryFilter that ensures that entities have at least one of the components
+type HasAny4[A, B, C, D any] struct{}
+
+func (HasAny4[A, B, C, D]) Filters() []Filter {
+	return []Filter{AnyOf(compIdOf[A](), compIdOf[B](), compIdOf[C](), compIdOf[D]())}
+}
+
+// A QueryFilter that passes entities that pass either A or B, eg: Either[Has[player], Has[enemy]]. This is the type level version of Or
+type Either[A, B QueryFilter] struct{}
+
+func (Either[A, B]) Filters() []Filter {
+	var a A
+	var b B
+	return []Filter{Or(And(a.Filters()...), And(b.Filters()...))}
+}
+
+// A QueryFilter that passes entities that don't pass F, eg: Negate[Filters2[Has[enemy], Has[frozen]]]. This is the type level version of Not
+type Negate[F QueryFilter] struct{}
+
+func (Negate[F]) Filters() []Filter {
+	var f F
+	return []Filter{Not(And(f.Filters()...))}
+}
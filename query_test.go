@@ -1,6 +1,11 @@
 package ecs
 
-import "testing"
+import (
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+)
 
 func TestQuery(t *testing.T) {
 	world := NewWorld()
@@ -26,3 +31,259 @@ func TestQuery(t *testing.T) {
 		check(t, ok)
 	}
 }
+
+func TestMapChunks(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 0)
+	for i := 0; i < 10; i++ {
+		v := float64(i)
+		ids = append(ids, world.Spawn(C(position{v, v, v}), C(velocity{v, v, v})))
+	}
+	world.Spawn(C(position{}), C(radius{})) // Second archetype, which is missing the optional velocity
+
+	// Make some holes, including at the start and end of the archetype
+	Delete(world, ids[0])
+	Delete(world, ids[4])
+	Delete(world, ids[5])
+	Delete(world, ids[9])
+
+	query := Query2[position, velocity](world, Optional(velocity{}))
+	chunks := 0
+	total := 0
+	query.MapChunks(func(chunkIds []Id, pos []position, vel []velocity) {
+		chunks++
+		total += len(chunkIds)
+		compare(t, len(pos), len(chunkIds))
+		compare(t, cap(pos), len(chunkIds))
+
+		for i, id := range chunkIds {
+			check(t, id != InvalidEntity)
+			if vel == nil {
+				continue
+			}
+			compare(t, len(vel), len(chunkIds))
+			compare(t, pos[i].x, vel[i].x)
+			vel[i].x += 100 // Writes go straight to the world
+		}
+	})
+	compare(t, chunks, 3)
+	compare(t, total, 7)
+
+	v, _ := Read[velocity](world, ids[1])
+	compare(t, v.x, 101.0)
+}
+
+func TestViewAll(t *testing.T) {
+	world := NewWorldWithOptions(WorldOptions{DebugChecks: true})
+	for i := 0; i < 10; i++ {
+		world.Spawn(C(position{float64(i), 0, 0}), C(velocity{1, 1, 1}))
+	}
+	world.Spawn(C(position{}))
+	world.Spawn(C(position{}), C(velocity{}), C(stunned{}))
+
+	query := Query2[position, velocity](world)
+	count := 0
+	for id, row := range query.All() {
+		check(t, world.Exists(id))
+		row.A.x += row.B.x
+		count++
+	}
+	compare(t, count, 11)
+
+	// Breaking out early should stop the iteration and finish mapping the view
+	count = 0
+	for range query.All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	compare(t, count, 3)
+	world.CleanupHoles() // Would panic if the view was still mapping
+
+	// Iterators can be composed with other iterators
+	sum := 0.0
+	for pos := range positions(query.All()) {
+		sum += pos.x
+	}
+	compare(t, sum, 55.0) // 0 + 1 + ... + 9, plus 1 from the velocity of each of those entities
+
+	sparse := Query2[position, stunned](world)
+	for _, row := range sparse.All() {
+		check(t, row.A != nil)
+		check(t, row.B != nil)
+	}
+}
+
+func positions[T any](seq iter.Seq2[Id, Row2[position, T]]) iter.Seq[*position] {
+	return func(yield func(*position) bool) {
+		for _, row := range seq {
+			if !yield(row.A) {
+				return
+			}
+		}
+	}
+}
+
+func TestFilterExpressions(t *testing.T) {
+	world := NewWorld()
+	player := world.Spawn(C(position{}), C(velocity{}))
+	enemyId := world.Spawn(C(position{}), C(enemy{}))
+	deadEnemy := world.Spawn(C(position{}), C(enemy{}), C(frozen{}))
+	world.Spawn(C(position{}), C(radius{}))
+
+	matched := func(query *View1[position]) []Id {
+		ids := make([]Id, 0)
+		query.MapId(func(id Id, p *position) {
+			ids = append(ids, id)
+		})
+		return ids
+	}
+
+	query := Query1[position](world, Or(With(velocity{}), With(enemy{})), Not(With(frozen{})))
+	compare(t, query.Count(), 2)
+	check(t, slices.Equal(matched(query), []Id{player, enemyId}))
+
+	query = Query1[position](world, AnyOf(velocity{}, frozen{}))
+	check(t, slices.Equal(matched(query), []Id{player, deadEnemy}))
+
+	query = Query1[position](world, And(With(enemy{}), Or(With(frozen{}), Without(enemy{}))))
+	check(t, slices.Equal(matched(query), []Id{deadEnemy}))
+
+	// Multiple Without filters should all be applied
+	query = Query1[position](world, Without(velocity{}), Without(enemy{}))
+	compare(t, query.Count(), 1)
+
+	// New archetypes are matched when the filter regenerates
+	query = Query1[position](world, Or(With(velocity{}), With(enemy{})), Not(With(frozen{})))
+	late := world.Spawn(C(position{}), C(velocity{}), C(acceleration{}))
+	compare(t, query.Count(), 3)
+	world.Write(late, C(frozen{}))
+	compare(t, query.Count(), 2)
+
+	// Optional can't be nested, because it doesn't filter archetypes
+	func() {
+		defer func() { check(t, recover() != nil) }()
+		Or(Optional(velocity{}), With(enemy{}))
+	}()
+	func() {
+		defer func() { check(t, recover() != nil) }()
+		Not(Optional(velocity{}))
+	}()
+
+	defer func() {
+		check(t, recover() != nil)
+	}()
+	Query1[position](world, Or(With(stunned{}), With(enemy{}))) // Sparse components can't be used in expressions
+}
+
+func TestViewMatchesAndGet(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), C(velocity{2, 2, 2}))
+	b := world.Spawn(C(position{}), C(velocity{}), C(frozen{}))
+	c := world.Spawn(C(position{}))
+	d := world.Spawn(C(position{}), C(velocity{}), C(stunned{}))
+
+	query := Query2[position, velocity](world, Without(frozen{}, stunned{}))
+	check(t, query.Matches(a))
+	check(t, !query.Matches(b))
+	check(t, !query.Matches(c))
+	check(t, !query.Matches(d)) // Sparse components are checked too
+	check(t, !query.Matches(InvalidEntity))
+
+	p, v, ok := query.Get(a)
+	check(t, ok)
+	compare(t, *p, position{1, 1, 1})
+	compare(t, *v, velocity{2, 2, 2})
+
+	p, v, ok = query.Get(b)
+	check(t, !ok)
+	check(t, p == nil && v == nil)
+
+	// Matching changes when the entity moves to another archetype
+	world.Write(c, C(velocity{}))
+	check(t, query.Matches(c))
+	Delete(world, a)
+	check(t, !query.Matches(a))
+	DeleteComponent(world, d, C(stunned{}))
+	check(t, query.Matches(d))
+
+	optional := Query2[position, velocity](world, Optional(velocity{}))
+	e := world.Spawn(C(position{}))
+	_, v, ok = optional.Get(e)
+	check(t, ok)
+	check(t, v == nil)
+}
+
+func TestViewSingleAndFirst(t *testing.T) {
+	world := NewWorld()
+	query := Query2[position, velocity](world)
+
+	_, _, _, err := query.Single()
+	check(t, errors.Is(err, ErrNoMatch))
+	_, _, _, ok := query.First()
+	check(t, !ok)
+
+	world.Spawn(C(position{}))
+	player := world.Spawn(C(position{1, 2, 3}), C(velocity{4, 5, 6}))
+
+	id, p, v, err := query.Single()
+	check(t, err == nil)
+	compare(t, id, player)
+	compare(t, *p, position{1, 2, 3})
+	compare(t, *v, velocity{4, 5, 6})
+
+	other := world.Spawn(C(position{}), C(velocity{}), C(radius{}))
+	id, p, v, err = query.Single()
+	check(t, errors.Is(err, ErrMultipleMatches))
+	compare(t, id, InvalidEntity)
+	check(t, p == nil && v == nil)
+
+	// First skips holes
+	Delete(world, player)
+	id, _, _, ok = query.First()
+	check(t, ok)
+	compare(t, id, other)
+}
+
+func TestViewMapIds(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 0, 0}), C(velocity{}))
+	b := world.Spawn(C(position{2, 0, 0}), C(velocity{}), C(radius{}))
+	c := world.Spawn(C(position{3, 0, 0}), C(velocity{}))
+	noVel := world.Spawn(C(position{4, 0, 0}))
+	s := world.Spawn(C(position{5, 0, 0}), C(velocity{}), C(stunned{}))
+	deleted := world.Spawn(C(position{6, 0, 0}), C(velocity{}))
+	Delete(world, deleted)
+
+	query := Query2[position, velocity](world, Without(stunned{}))
+	visited := make([]Id, 0)
+	query.MapIds([]Id{b, noVel, c, deleted, s, a, InvalidEntity}, func(id Id, p *position, v *velocity) {
+		p.x *= 10
+		visited = append(visited, id)
+	})
+
+	// Grouped by archetype, then by index
+	check(t, slices.Equal(visited, []Id{a, c, b}))
+	for _, id := range []Id{a, b, c} {
+		p, _ := Read[position](world, id)
+		check(t, p.x >= 10)
+	}
+	p, _ := Read[position](world, noVel)
+	compare(t, p.x, 4.0)
+
+	// Repeated ids are only visited once
+	visited = visited[:0]
+	query.MapIds([]Id{c, a, c, b, a, c}, func(id Id, p *position, v *velocity) {
+		visited = append(visited, id)
+	})
+	check(t, slices.Equal(visited, []Id{a, c, b}))
+
+	// Entities that are deleted by the lambda are skipped
+	visited = visited[:0]
+	query.MapIds([]Id{a, c}, func(id Id, p *position, v *velocity) {
+		visited = append(visited, id)
+		Delete(world, c)
+	})
+	check(t, slices.Equal(visited, []Id{a}))
+}
@@ -0,0 +1,172 @@
+package ecs
+
+import "slices"
+
+// Tracks when entities start or stop matching a view. Unlike OnAdd hooks, which run for each component, this runs once when an entity starts matching the whole query (eg. when it has both a Sprite and a Position) and once when it stops.
+// Events are delivered after the structural change that caused them has finished, so the world is always consistent inside of the callbacks. Exit events for deleted entities are delivered after the entity is gone, so its components can't be read anymore.
+// If a callback isn't set, the events are buffered until they are collected with Drain.
+type ReactiveQuery struct {
+	world  *World
+	filter filterList
+
+	onEnter func(Id)
+	onExit  func(Id)
+	entered []Id // Buffered events, for when there is no callback
+	exited  []Id
+	closed  bool
+}
+
+type reactiveEvent struct {
+	query *ReactiveQuery
+	id    Id
+	enter bool
+}
+
+// Creates a reactive query with the same components and filters as the view, and starts tracking it.
+// Entities that already match the view don't generate any enter events. Sparse components can't be used, because adding and removing them doesn't move the entity.
+func NewReactiveQuery(view AnyView) *ReactiveQuery {
+	world, filter := view.viewFilter()
+	if filter.hasSparse() {
+		panic("ecs: sparse components can't be used in reactive queries")
+	}
+
+	q := &ReactiveQuery{
+		world: world,
+		filter: filterList{
+			comps:           filter.comps,
+			withoutArchMask: filter.withoutArchMask,
+			exprs:           filter.exprs,
+		},
+	}
+	world.engine.reactive = append(world.engine.reactive, q)
+	return q
+}
+
+// Sets the function that is called when an entity starts matching the query
+func (q *ReactiveQuery) OnEnter(lambda func(id Id)) {
+	q.onEnter = lambda
+}
+
+// Sets the function that is called when an entity stops matching the query
+func (q *ReactiveQuery) OnExit(lambda func(id Id)) {
+	q.onExit = lambda
+}
+
+// Returns the entities that entered and exited the query since the last call, for events that don't have a callback.
+// The returned slices belong to the caller, later events are buffered in new slices
+func (q *ReactiveQuery) Drain() (entered, exited []Id) {
+	entered, exited = q.entered, q.exited
+	q.entered, q.exited = nil, nil
+	return entered, exited
+}
+
+// Stops tracking the query, no more events will be delivered
+func (q *ReactiveQuery) Close() {
+	q.closed = true // Note: Events that are already queued are skipped, because Close can be called by a callback while they are being delivered
+	e := q.world.engine
+	e.reactive = slices.DeleteFunc(e.reactive, func(other *ReactiveQuery) bool {
+		return other == q
+	})
+}
+
+func (q *ReactiveQuery) matchesArchetype(archId archetypeId) bool {
+	q.filter.regenerate(q.world)
+	return q.filter.archSet[archId]
+}
+
+func (q *ReactiveQuery) deliver(event reactiveEvent) {
+	if q.closed {
+		return
+	}
+	if event.enter {
+		if q.onEnter != nil {
+			q.onEnter(event.id)
+		} else {
+			q.entered = append(q.entered, event.id)
+		}
+	} else {
+		if q.onExit != nil {
+			q.onExit(event.id)
+		} else {
+			q.exited = append(q.exited, event.id)
+		}
+	}
+}
+
+// Queues enter events for an entity that was added to the archetype
+func (e *archEngine) notifyEnter(id Id, archId archetypeId) {
+	for _, q := range e.reactive {
+		if q.matchesArchetype(archId) {
+			e.reactiveEvents = append(e.reactiveEvents, reactiveEvent{q, id, true})
+		}
+	}
+}
+
+// Queues exit events for an entity that was removed from the archetype
+func (e *archEngine) notifyExit(id Id, archId archetypeId) {
+	for _, q := range e.reactive {
+		if q.matchesArchetype(archId) {
+			e.reactiveEvents = append(e.reactiveEvents, reactiveEvent{q, id, false})
+		}
+	}
+}
+
+// Queues events for an entity that moved between the archetypes, for every query that only matches one of them
+func (e *archEngine) notifyMove(id Id, from, to archetypeId) {
+	for _, q := range e.reactive {
+		enter := q.matchesArchetype(to)
+		if q.matchesArchetype(from) != enter {
+			e.reactiveEvents = append(e.reactiveEvents, reactiveEvent{q, id, enter})
+		}
+	}
+}
+
+// Queues events for a whole table of entities that moved between the archetypes. Holes in the ids are skipped
+func (e *archEngine) notifyMoveTable(ids []Id, from, to archetypeId) {
+	for _, q := range e.reactive {
+		enter := q.matchesArchetype(to)
+		if q.matchesArchetype(from) == enter {
+			continue
+		}
+		for _, id := range ids {
+			if id == InvalidEntity {
+				continue
+			}
+			e.reactiveEvents = append(e.reactiveEvents, reactiveEvent{q, id, enter})
+		}
+	}
+}
+
+// Queues exit events for every entity in the archetype
+func (e *archEngine) notifyExitArchetype(archId archetypeId) {
+	for _, q := range e.reactive {
+		if !q.matchesArchetype(archId) {
+			continue
+		}
+		for _, id := range e.lookup[archId].id {
+			if id == InvalidEntity {
+				continue
+			}
+			e.reactiveEvents = append(e.reactiveEvents, reactiveEvent{q, id, false})
+		}
+	}
+}
+
+// Delivers every queued event. This must only be called once the world is consistent again.
+// Callbacks can cause more structural changes, their events get delivered by the same loop
+func (e *archEngine) flushReactive() {
+	if e.flushingReactive || len(e.reactiveEvents) == 0 {
+		return
+	}
+
+	e.flushingReactive = true
+	defer func() {
+		e.reactiveEvents = e.reactiveEvents[:0]
+		e.flushingReactive = false
+	}()
+
+	for i := 0; i < len(e.reactiveEvents); i++ {
+		event := e.reactiveEvents[i]
+		event.query.deliver(event)
+	}
+}
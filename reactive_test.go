@@ -0,0 +1,119 @@
+package ecs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReactiveQueryCallbacks(t *testing.T) {
+	world := NewWorld()
+	existing := world.Spawn(C(position{}), C(velocity{}))
+
+	query := NewReactiveQuery(Query2[position, velocity](world, Without(frozen{})))
+	entered := make([]Id, 0)
+	exited := make([]Id, 0)
+	query.OnEnter(func(id Id) {
+		// The world is consistent inside of the callback
+		_, ok := Read[velocity](world, id)
+		check(t, ok)
+		entered = append(entered, id)
+	})
+	query.OnExit(func(id Id) {
+		exited = append(exited, id)
+	})
+
+	id := world.Spawn(C(position{}))
+	compare(t, len(entered), 0)
+	world.Write(id, C(velocity{}))
+	check(t, slices.Equal(entered, []Id{id}))
+
+	// Moving between two matching archetypes isn't an enter or exit
+	world.Write(id, C(radius{}))
+	compare(t, len(entered), 1)
+	compare(t, len(exited), 0)
+
+	world.Write(id, C(frozen{}))
+	check(t, slices.Equal(exited, []Id{id}))
+	DeleteComponent(world, id, C(frozen{}))
+	check(t, slices.Equal(entered, []Id{id, id}))
+	DeleteComponent(world, id, C(velocity{}))
+	check(t, slices.Equal(exited, []Id{id, id}))
+
+	Delete(world, existing)
+	check(t, slices.Equal(exited, []Id{id, id, existing}))
+
+	// Spawns through commands and bundles
+	cmd := world.Cmd()
+	spawned := cmd.SpawnEmpty().Insert(C(position{})).Insert(C(velocity{})).Id()
+	cmd.Execute()
+	bundled := NewBundle2[position, velocity]().Spawn(world, position{}, velocity{})
+	check(t, slices.Equal(entered, []Id{id, id, spawned, bundled}))
+
+	query.Close()
+	world.Spawn(C(position{}), C(velocity{}))
+	compare(t, len(entered), 4)
+}
+
+func TestReactiveQueryDrain(t *testing.T) {
+	world := NewWorld()
+	query := NewReactiveQuery(Query1[position](world, With(enemy{})))
+
+	ids := SpawnBatch(world, 3, C(position{}))
+	entered, exited := query.Drain()
+	compare(t, len(entered), 0)
+	compare(t, len(exited), 0)
+
+	// Bulk operations report every entity they move
+	compare(t, AddToMatching(Query1[position](world), C(enemy{})), 3)
+	entered, _ = query.Drain()
+	check(t, slices.Equal(entered, ids))
+
+	Delete(world, ids[0])
+	compare(t, RemoveFromMatching(Query1[position](world, With(radius{})), C(enemy{})), 0)
+	_, exited = query.Drain()
+	check(t, slices.Equal(exited, ids[:1]))
+
+	more := SpawnBatch(world, 2, C(position{}), C(enemy{}))
+	compare(t, DeleteMatching(Query1[enemy](world)), 4)
+	entered, exited = query.Drain()
+	check(t, slices.Equal(entered, more))
+	compare(t, len(exited), 4)
+
+	world.Spawn(C(position{}), C(enemy{}))
+	world.Reset()
+	entered, exited = query.Drain()
+	compare(t, len(entered), 1)
+	compare(t, len(exited), 1)
+
+	// Drained events aren't overwritten by later ones
+	first := world.Spawn(C(position{}), C(enemy{}))
+	entered, _ = query.Drain()
+	second := world.Spawn(C(position{}), C(enemy{}))
+	check(t, slices.Equal(entered, []Id{first}))
+	later, _ := query.Drain()
+	check(t, slices.Equal(later, []Id{second}))
+}
+
+func TestReactiveQueryStructuralChangesInCallbacks(t *testing.T) {
+	world := NewWorld()
+	moving := NewReactiveQuery(Query2[position, velocity](world))
+	frozenQuery := NewReactiveQuery(Query1[frozen](world))
+
+	// Freeze everything that starts moving, which makes it stop moving
+	moving.OnEnter(func(id Id) {
+		DeleteComponent(world, id, C(velocity{}))
+		world.Write(id, C(frozen{}))
+	})
+
+	id := world.Spawn(C(position{}), C(velocity{}))
+	_, exited := moving.Drain()
+	check(t, slices.Equal(exited, []Id{id}))
+	entered, _ := frozenQuery.Drain()
+	check(t, slices.Equal(entered, []Id{id}))
+	check(t, !world.hasCompId(id, velocity{}.CompId()))
+
+	defer func() {
+		check(t, recover() != nil)
+	}()
+	NewReactiveQuery(Query1[stunned](world))
+}
@@ -0,0 +1,118 @@
+package ecs
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Component and event ids are process global: every World in the process shares the same ids, but each World has its own storage.
+// Ids are handed out in the order that types are first used, and they never change for the lifetime of the process.
+// If you need ids to be the same across runs (for example, in tests that compare ids), register your types in a fixed order at startup, eg. with NewComp or NewEvent.
+// All registries are thread safe, so worlds can be created and used on different goroutines.
+
+// A thread safe mapping of types to ids. Lookups are lock free, registering a new type takes a lock and copies the map, which only happens once per type.
+type typeRegistry[K ~uint16 | ~int] struct {
+	mu       sync.Mutex
+	ids      atomic.Pointer[map[reflect.Type]K]
+	next     K
+	validate func(name string, id K) // Optional, called with the new id before a type is registered. May panic
+}
+
+func newTypeRegistry[K ~uint16 | ~int](first K, validate func(string, K)) *typeRegistry[K] {
+	return &typeRegistry[K]{
+		next:     first,
+		validate: validate,
+	}
+}
+
+// Returns the id for the type, registering it if it doesn't have one yet
+func (r *typeRegistry[K]) get(typeof reflect.Type) K {
+	ids := r.ids.Load()
+	if ids != nil {
+		id, ok := (*ids)[typeof]
+		if ok {
+			return id
+		}
+	}
+
+	return r.register(typeof)
+}
+
+func (r *typeRegistry[K]) register(typeof reflect.Type) K {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Check again, in case someone else registered it while we were waiting for the lock
+	old := r.ids.Load()
+	if old != nil {
+		id, ok := (*old)[typeof]
+		if ok {
+			return id
+		}
+	}
+
+	id := r.next
+	if r.validate != nil {
+		r.validate(typeof.String(), id)
+	}
+
+	ids := make(map[reflect.Type]K, int(id)+1)
+	if old != nil {
+		for k, v := range *old {
+			ids[k] = v
+		}
+	}
+	ids[typeof] = id
+	r.next++
+
+	r.ids.Store(&ids)
+	return id
+}
+
+// Hands out a new id that isn't associated with any Go type. The name is only used for error messages
+func (r *typeRegistry[K]) reserve(name string) K {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := r.next
+	if r.validate != nil {
+		r.validate(name, id)
+	}
+	r.next++
+	return id
+}
+
+// A thread safe set of component ids, used to flag components that need special storage. Adding copies the mask, so reads are lock free
+type componentSet struct {
+	mu   sync.Mutex
+	mask atomic.Pointer[archetypeMask]
+}
+
+func (s *componentSet) add(compId CompId) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mask := s.get()
+	if mask.hasComponent(compId) {
+		return
+	}
+	mask.addComponent(compId)
+	s.mask.Store(&mask)
+}
+
+func (s *componentSet) get() archetypeMask {
+	mask := s.mask.Load()
+	if mask == nil {
+		return blankArchMask
+	}
+	return *mask
+}
+
+func (s *componentSet) has(compId CompId) bool {
+	mask := s.mask.Load()
+	if mask == nil {
+		return false
+	}
+	return mask.hasComponent(compId)
+}
@@ -0,0 +1,111 @@
+package ecs
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestTypeRegistryConcurrent(t *testing.T) {
+	registry := newTypeRegistry[int](10, nil)
+	types := []reflect.Type{
+		reflect.TypeFor[position](),
+		reflect.TypeFor[velocity](),
+		reflect.TypeFor[acceleration](),
+		reflect.TypeFor[radius](),
+	}
+
+	const workers = 8
+	results := make([][]int, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range types {
+				// Every worker registers in a different order
+				results[i] = append(results[i], registry.get(types[(i+j)%len(types)]))
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[int]bool)
+	for _, typeof := range types {
+		id := registry.get(typeof)
+		check(t, id >= 10 && id < 10+len(types))
+		check(t, !seen[id])
+		seen[id] = true
+	}
+	for i := range results {
+		for j := range types {
+			compare(t, results[i][j], registry.get(types[(i+j)%len(types)]))
+		}
+	}
+}
+
+type testEventA struct{}
+type testEventB struct{}
+
+func TestNewEventConcurrent(t *testing.T) {
+	const workers = 8
+	results := make([][2]EventId, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = [2]EventId{NewEvent[testEventA](), NewEvent[testEventB]()}
+		}()
+	}
+	wg.Wait()
+
+	check(t, results[0][0] != results[0][1])
+	for i := range results {
+		compare(t, results[i], results[0])
+	}
+}
+
+func TestWorldsShareIdsButNotStorage(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			world := NewWorld()
+			v := float64(i)
+			ids := make([]Id, 0)
+			for range 100 {
+				ids = append(ids, world.Spawn(C(position{v, v, v}), C(velocity{v, v, v})))
+			}
+
+			compare(t, Query2[position, velocity](world).Count(), 100)
+			for _, id := range ids {
+				p, ok := Read[position](world, id)
+				check(t, ok)
+				compare(t, p, position{v, v, v})
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestComponentRegistryOverflow(t *testing.T) {
+	// Note: A local registry that starts at the last id, so that the global one doesn't run out
+	registry := newTypeRegistry(CompId(maxComponentId), validateComponentId)
+	compare(t, registry.get(reflect.TypeFor[position]()), CompId(maxComponentId))
+
+	expected := fmt.Sprintf("ecs: can't register component ecs.velocity, the maximum of %d component types has been reached. Build with the ecs_components512 or ecs_components1024 tags to support more", maxComponentId)
+	func() {
+		defer func() { compare(t, recover(), any(expected)) }()
+		registry.get(reflect.TypeFor[velocity]())
+	}()
+	func() {
+		defer func() { check(t, recover() != nil) }()
+		registry.reserve("dynamic")
+	}()
+
+	// The failed registrations don't use up any ids
+	compare(t, registry.get(reflect.TypeFor[position]()), CompId(maxComponentId))
+}
@@ -0,0 +1,118 @@
+package ecs
+
+import (
+	"unsafe"
+)
+
+// Sparse components are stored in a sparse set keyed by entity Id, rather than in the archetype tables.
+// They don't participate in the archetypeMask, so adding or removing them never moves the entity to a different archetype.
+// This makes toggling them O(1), at the cost of slower iteration: views check sparse components for every entity they visit.
+
+var sparseComponents componentSet
+
+// Creates a component that uses sparse set storage. This is useful for components that get added and removed frequently, like status effects.
+// You must call this before the component is used in any world, otherwise entities that already have it will stay in their old archetypes.
+func NewSparseComp[T any]() comp[T] {
+	c := NewComp[T]()
+	sparseComponents.add(c.compId)
+	return c
+}
+
+// Returns the mask of every component that uses sparse storage
+func getSparseMask() archetypeMask {
+	return sparseComponents.get()
+}
+
+// Returns the mask with every sparse component removed
+func (m archetypeMask) withoutSparse() archetypeMask {
+	return m.bitwiseClear(sparseComponents.get())
+}
+
+func isSparse(compId CompId) bool {
+	return sparseComponents.has(compId)
+}
+
+// Implemented by every storage that can hold sparse components
+type sparseStorage interface {
+	hasSparse(Id) bool
+	removeSparse(Id) bool
+	sparsePtr(Id) unsafe.Pointer // Returns nil if the id doesn't have the component
+}
+
+// --------------------------------------------------------------------------------
+// - Sparse Set
+// --------------------------------------------------------------------------------
+type sparseSet[T any] struct {
+	index *internalMap[Id, int] // Maps the Id to its index in the dense lists
+	ids   []Id
+	comp  []T
+}
+
+func newSparseSet[T any](allocation int) *sparseSet[T] {
+	return &sparseSet[T]{
+		index: newMap[Id, int](0),
+		ids:   make([]Id, 0, allocation),
+		comp:  make([]T, 0, allocation),
+	}
+}
+
+func (s *sparseSet[T]) Len() int {
+	return len(s.ids)
+}
+
+// Returns a pointer to the component for the id, or nil if the id doesn't have one
+func (s *sparseSet[T]) get(id Id) *T {
+	index, ok := s.index.Get(id)
+	if !ok {
+		return nil
+	}
+	return &s.comp[index]
+}
+
+func (s *sparseSet[T]) has(id Id) bool {
+	return s.index.Has(id)
+}
+
+// Writes the component for the id. Returns true if the id didn't have the component before
+func (s *sparseSet[T]) write(id Id, val T) bool {
+	index, ok := s.index.Get(id)
+	if ok {
+		s.comp[index] = val
+		return false
+	}
+
+	s.index.Put(id, len(s.ids))
+	s.ids = append(s.ids, id)
+	s.comp = append(s.comp, val)
+	return true
+}
+
+// Removes the component for the id, by swapping the last element into its place. Returns true if the id had the component
+func (s *sparseSet[T]) remove(id Id) bool {
+	index, ok := s.index.Get(id)
+	if !ok {
+		return false
+	}
+	s.index.Delete(id)
+
+	lastIndex := len(s.ids) - 1
+	if index != lastIndex {
+		lastId := s.ids[lastIndex]
+		s.ids[index] = lastId
+		s.comp[index] = s.comp[lastIndex]
+		s.index.Put(lastId, index)
+	}
+
+	var zero T
+	s.comp[lastIndex] = zero // Release anything the component was holding onto
+	s.ids = s.ids[:lastIndex]
+	s.comp = s.comp[:lastIndex]
+	return true
+}
+
+func (s *sparseSet[T]) clear() {
+	s.index.Clear()
+	clear(s.comp)
+	s.ids = s.ids[:0]
+	s.comp = s.comp[:0]
+}
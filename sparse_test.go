@@ -0,0 +1,205 @@
+package ecs
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+type stunned struct {
+	turns int
+}
+
+var stunnedId = NewSparseComp[stunned]()
+
+func TestSparseToggleKeepsArchetype(t *testing.T) {
+	world := NewWorld()
+	id := world.Spawn(C(position{1, 1, 1}), C(velocity{2, 2, 2}))
+	loc, _ := world.arch.Get(id)
+
+	world.Write(id, C(stunned{3}))
+	newLoc, _ := world.arch.Get(id)
+	compare(t, newLoc, loc)
+
+	s, ok := Read[stunned](world, id)
+	check(t, ok)
+	compare(t, s, stunned{3})
+	check(t, world.hasCompId(id, stunnedId.CompId()))
+
+	DeleteComponent(world, id, C(stunned{}))
+	newLoc, _ = world.arch.Get(id)
+	compare(t, newLoc, loc)
+
+	_, ok = Read[stunned](world, id)
+	check(t, !ok)
+	p, ok := Read[position](world, id)
+	check(t, ok)
+	compare(t, p, position{1, 1, 1})
+}
+
+func TestSparseView(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), C(stunned{1}))
+	b := world.Spawn(C(position{2, 2, 2}))
+	c := world.Spawn(C(stunned{3})) // Only sparse components
+
+	query := Query2[position, stunned](world)
+	compare(t, query.Count(), 1)
+	query.MapId(func(id Id, p *position, s *stunned) {
+		compare(t, id, a)
+		compare(t, *s, stunned{1})
+	})
+
+	p, s := query.Read(b)
+	compare(t, *p, position{2, 2, 2})
+	check(t, s == nil)
+
+	optional := Query2[position, stunned](world, Optional(stunned{}))
+	compare(t, optional.Count(), 2)
+	optional.MapId(func(id Id, p *position, s *stunned) {
+		if id == b {
+			check(t, s == nil)
+		} else {
+			check(t, s != nil)
+		}
+	})
+
+	without := Query1[position](world, Without(stunned{}))
+	compare(t, without.Count(), 1)
+	without.MapId(func(id Id, p *position) {
+		compare(t, id, b)
+	})
+
+	sparseOnly := Query1[stunned](world)
+	compare(t, sparseOnly.Count(), 2)
+	found := 0
+	sparseOnly.MapIdParallel(func(id Id, s *stunned) {
+		if id == c {
+			compare(t, *s, stunned{3})
+		}
+	})
+	sparseOnly.MapId(func(id Id, s *stunned) {
+		found++
+	})
+	compare(t, found, 2)
+}
+
+// Views with sparse components use separate loops, so every way of mapping should find the same entities and components
+func TestSparseEveryLoop(t *testing.T) {
+	world := NewWorld()
+	var ids []Id
+	for i := range 10 {
+		id := world.Spawn(C(position{float64(i), 0, 0}))
+		if i%2 == 0 {
+			world.Write(id, C(stunned{i}))
+		}
+		ids = append(ids, id)
+	}
+	Delete(world, ids[3])
+
+	query := Query2[position, stunned](world)
+	expected := map[Id]int{}
+	query.MapId(func(id Id, p *position, s *stunned) {
+		compare(t, s.turns, int(p.x))
+		expected[id] = s.turns
+	})
+	compare(t, len(expected), 5)
+
+	visit := func() (map[Id]bool, func(Id, *position, *stunned)) {
+		found := map[Id]bool{}
+		return found, func(id Id, p *position, s *stunned) {
+			check(t, s != nil)
+			compare(t, s.turns, expected[id])
+			found[id] = true
+		}
+	}
+
+	found, lambda := visit()
+	for id, row := range query.All() {
+		lambda(id, row.A, row.B)
+	}
+	compare(t, len(found), len(expected))
+
+	found, lambda = visit()
+	query.MapIds(ids, lambda)
+	compare(t, len(found), len(expected))
+
+	found, lambda = visit()
+	query.MapIdOrdered(ById(), lambda)
+	compare(t, len(found), len(expected))
+
+	found, lambda = visit()
+	cursor := &QueryCursor{MaxEntities: 2}
+	for !query.MapIdCursor(cursor, lambda) {
+	}
+	compare(t, len(found), len(expected))
+
+	// The parallel workers can't write to a shared map, so just count them
+	var count atomic.Int32
+	query.MapIdParallel(func(id Id, p *position, s *stunned) {
+		check(t, s != nil)
+		count.Add(1)
+	})
+	compare(t, int(count.Load()), len(expected))
+}
+
+func TestSparseDelete(t *testing.T) {
+	world := NewWorld()
+	id := world.Spawn(C(position{}), C(stunned{1}))
+
+	// Removing the last archetype component keeps the entity, because it still has a sparse component
+	DeleteComponent(world, id, C(position{}))
+	check(t, world.Exists(id))
+	_, ok := Read[stunned](world, id)
+	check(t, ok)
+
+	Delete(world, id)
+	check(t, !world.Exists(id))
+	check(t, !world.engine.hasSparse(stunnedId.CompId(), id))
+
+	// Ids can be reused, so the sparse component must not come back
+	world.Write(id, C(position{}))
+	_, ok = Read[stunned](world, id)
+	check(t, !ok)
+}
+
+func TestSparseHooksAndCommands(t *testing.T) {
+	world := NewWorld()
+
+	added := 0
+	world.SetHookOnAdd(C(stunned{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		added++
+	}))
+
+	id := world.Spawn(C(position{}))
+	world.Write(id, C(stunned{1}))
+	compare(t, added, 1)
+	world.Write(id, C(stunned{2})) // Overwriting isn't an add
+	compare(t, added, 1)
+
+	cmd := world.Cmd()
+	id2 := cmd.SpawnEmpty().Insert(C(stunned{5})).Id()
+	cmd.Execute()
+	compare(t, added, 2)
+	s, ok := Read[stunned](world, id2)
+	check(t, ok)
+	compare(t, s, stunned{5})
+}
+
+func TestSparseSetRemove(t *testing.T) {
+	set := newSparseSet[int](0)
+	for i := 1; i <= 5; i++ {
+		check(t, set.write(Id(i), i*10))
+	}
+	check(t, !set.write(Id(3), 33))
+
+	check(t, set.remove(Id(1)))
+	check(t, !set.remove(Id(1)))
+	compare(t, set.Len(), 4)
+	for i := 2; i <= 5; i++ {
+		expected := i * 10
+		if i == 3 {
+			expected = 33
+		}
+		compare(t, *set.get(Id(i)), expected)
+	}
+}
@@ -1,11 +1,26 @@
 package ecs
 
+import (
+	"reflect"
+	"slices"
+	"unsafe"
+)
+
 type storage interface {
-	ReadToEntity(*Entity, archetypeId, int) bool
-	ReadToRawEntity(*RawEntity, archetypeId, int) bool
-	Allocate(archetypeId, int) // Allocates the index, setting the data there to the zero value
+	ReadToEntity(*Entity, entLoc, Id) bool
+	ReadToRawEntity(*RawEntity, entLoc, Id) bool
+	Allocate(archetypeId, int)      // Allocates the index, setting the data there to the zero value
+	allocateBatch(archetypeId, int) // Appends n rows to the end of the archetype, setting them to the zero value
 	Delete(archetypeId, int)
-	moveArchetype(entLoc, entLoc) // From -> To
+	moveArchetype(entLoc, entLoc)       // From -> To
+	moveTable(archetypeId, archetypeId) // Appends every row of the first archetype onto the second, leaving the first empty
+	truncate(archetypeId)               // Removes every row of the archetype, but keeps the memory for reuse
+	clear()                             // Removes every row, but keeps the memory for reuse
+	reserve(archetypeId, int)           // Makes sure the archetype has room for n more rows
+	dropArchetype(archetypeId)          // Releases all of the memory used by the archetype
+
+	elemType() reflect.Type                    // The type stored in each row, rows are elemType().Size() bytes apart
+	column(archetypeId) (unsafe.Pointer, bool) // Returns a pointer to the first row of the archetype's column
 }
 
 // --------------------------------------------------------------------------------
@@ -16,7 +31,13 @@ type lookupList struct {
 	id         []Id  // An array of every id in the arch list (essentially a reverse mapping from index to Id)
 	holes      []int // List of indexes that have ben deleted
 	mask       archetypeMask
-	components []CompId // This is a list of all components that this archetype contains
+	components []CompId  // This is a list of all components that this archetype contains
+	storages   []storage // The storage of each component in the components list
+
+	addEdges    []archEdge // Archetype graph edges, see graph.go
+	removeEdges []archEdge
+
+	free bool // True if the archetype was removed and is waiting to be reused
 }
 
 func (l *lookupList) Len() int {
@@ -65,38 +86,135 @@ func (s *componentList[T]) Write(index int, val T) {
 // --------------------------------------------------------------------------------
 type componentStorage[T any] struct {
 	// TODO: Could these just increment rather than be a map lookup? I guess not every component type would have a storage slice for every archetype so we'd waste some memory. I guess at the very least we could use the faster lookup map
-	slice *internalMap[archetypeId, *componentList[T]]
+	slice      *internalMap[archetypeId, *componentList[T]]
+	allocation int    // The initial capacity of each archetype's list
+	compId     CompId // Cached, so that writes don't need to look up the id of T
+
+	sparse *sparseSet[T]     // Only set for sparse components, which never have any archetype slices
+	tag    *componentList[T] // Only set for tags, this list is shared by every archetype
 }
 
-func (ss *componentStorage[T]) ReadToEntity(entity *Entity, archId archetypeId, index int) bool {
-	cSlice, ok := ss.slice.Get(archId)
+// Returns a pointer to the component of the entity at the location, or nil if it doesn't have one
+func (ss *componentStorage[T]) get(loc entLoc, id Id) *T {
+	if ss.sparse != nil {
+		return ss.sparse.get(id)
+	}
+
+	cSlice, ok := ss.slice.Get(loc.archId)
 	if !ok {
+		return nil
+	}
+	return &cSlice.comp[loc.index]
+}
+
+func (ss *componentStorage[T]) ReadToEntity(entity *Entity, loc entLoc, id Id) bool {
+	val := ss.get(loc, id)
+	if val == nil {
 		return false
 	}
-	entity.Add(C(cSlice.comp[index]))
+	entity.Add(C(*val))
 	return true
 }
 
-func (ss *componentStorage[T]) ReadToRawEntity(entity *RawEntity, archId archetypeId, index int) bool {
-	cSlice, ok := ss.slice.Get(archId)
-	if !ok {
+func (ss *componentStorage[T]) ReadToRawEntity(entity *RawEntity, loc entLoc, id Id) bool {
+	val := ss.get(loc, id)
+	if val == nil {
 		return false
 	}
-	entity.Add(&cSlice.comp[index])
+	entity.Add(val)
 	return true
 }
 
+func (ss *componentStorage[T]) clear() {
+	ss.slice.ForEach(func(archId archetypeId, list *componentList[T]) {
+		if list == ss.tag {
+			return // The tag list is shared and always stays the same length
+		}
+		clear(list.comp) // Release anything the components were holding onto
+		list.comp = list.comp[:0]
+	})
+
+	if ss.sparse != nil {
+		ss.sparse.clear()
+	}
+}
+
+func (ss *componentStorage[T]) moveTable(from, to archetypeId) {
+	fromList, ok := ss.slice.Get(from)
+	if !ok {
+		return
+	}
+	toList := ss.GetSlice(to)
+	toList.comp = append(toList.comp, fromList.comp...)
+	ss.truncate(from)
+}
+
+func (ss *componentStorage[T]) truncate(archId archetypeId) {
+	list, ok := ss.slice.Get(archId)
+	if !ok || list == ss.tag {
+		return // The tag list is shared and always stays the same length
+	}
+	clear(list.comp) // Release anything the components were holding onto
+	list.comp = list.comp[:0]
+}
+
+func (ss *componentStorage[T]) reserve(archId archetypeId, n int) {
+	list := ss.GetSlice(archId)
+	list.comp = slices.Grow(list.comp, n)
+}
+
+func (ss *componentStorage[T]) dropArchetype(archId archetypeId) {
+	ss.slice.Delete(archId)
+}
+
+func (ss *componentStorage[T]) addTagArchetype(archId archetypeId) {
+	ss.slice.Put(archId, ss.tag)
+}
+
+func (ss *componentStorage[T]) hasSparse(id Id) bool {
+	if ss.sparse == nil {
+		return false
+	}
+	return ss.sparse.has(id)
+}
+
+func (ss *componentStorage[T]) sparsePtr(id Id) unsafe.Pointer {
+	if ss.sparse == nil {
+		return nil
+	}
+	return unsafe.Pointer(ss.sparse.get(id))
+}
+
+func (ss *componentStorage[T]) removeSparse(id Id) bool {
+	if ss.sparse == nil {
+		return false
+	}
+	return ss.sparse.remove(id)
+}
+
 func (ss *componentStorage[T]) GetSlice(archId archetypeId) *componentList[T] {
 	list, ok := ss.slice.Get(archId)
 	if !ok {
 		list = &componentList[T]{
-			comp: make([]T, 0, DefaultAllocation),
+			comp: make([]T, 0, ss.allocation),
 		}
 		ss.slice.Put(archId, list)
 	}
 	return list
 }
 
+func (ss *componentStorage[T]) elemType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (ss *componentStorage[T]) column(archId archetypeId) (unsafe.Pointer, bool) {
+	cSlice, ok := ss.slice.Get(archId)
+	if !ok {
+		return nil, false
+	}
+	return unsafe.Pointer(unsafe.SliceData(cSlice.comp)), true
+}
+
 func (ss *componentStorage[T]) Allocate(archId archetypeId, index int) {
 	cSlice := ss.GetSlice(archId)
 
@@ -104,6 +222,13 @@ func (ss *componentStorage[T]) Allocate(archId archetypeId, index int) {
 	cSlice.Write(index, val)
 }
 
+func (ss *componentStorage[T]) allocateBatch(archId archetypeId, n int) {
+	cSlice := ss.GetSlice(archId)
+	start := len(cSlice.comp)
+	cSlice.comp = slices.Grow(cSlice.comp, n)[:start+n]
+	clear(cSlice.comp[start:])
+}
+
 func (ss *componentStorage[T]) moveArchetype(oldLoc, newLoc entLoc) {
 	oldSlice, _ := ss.slice.Get(oldLoc.archId)
 	newSlice, _ := ss.slice.Get(newLoc.archId)
@@ -124,3 +249,11 @@ func (ss *componentStorage[T]) Delete(archId archetypeId, index int) {
 	cSlice.comp[index] = lastVal
 	cSlice.comp = cSlice.comp[:len(cSlice.comp)-1]
 }
+
+// Returns the rows [start, end) of the slice, capped so that appending to it can't overwrite the following rows. Returns nil if the slice is nil
+func chunkOf[T any](s []T, start, end int) []T {
+	if s == nil {
+		return nil
+	}
+	return s[start:end:end]
+}
@@ -37,6 +37,37 @@ func TestSystemCreationNew(t *testing.T) {
 	}
 }
 
+func TestSystemFilteredView(t *testing.T) {
+	world := NewWorld()
+	world.Spawn(C(position{}), C(velocity{1, 1, 1}))
+	world.Spawn(C(position{}), C(velocity{1, 1, 1}), C(frozen{}))
+	world.Spawn(C(position{}), C(velocity{1, 1, 1}), C(enemy{}))
+
+	count := 0
+	sys := NewSystem1(func(dt time.Duration, query *View2F[position, velocity, Filters2[Has[enemy], Lacks[frozen]]]) {
+		count = query.Count()
+	}).Build(world)
+	sys.step(16 * time.Millisecond)
+	compare(t, count, 1)
+
+	// Views with different filters are separate resources
+	unfiltered := GetInjectable[*View2[position, velocity]](world)
+	compare(t, unfiltered.Count(), 3)
+	notFrozen := GetInjectable[*View2F[position, velocity, Lacks[frozen]]](world)
+	compare(t, notFrozen.Count(), 2)
+
+	optional := Query2F[position, stunned, Maybe[stunned]](world)
+	compare(t, optional.Count(), 3)
+
+	// Filter expressions
+	compare(t, Query1F[position, HasAny2[enemy, frozen]](world).Count(), 2)
+	compare(t, Query1F[position, HasAny3[enemy, frozen, acceleration]](world).Count(), 2)
+	compare(t, Query1F[position, Either[Has[enemy], Has[frozen]]](world).Count(), 2)
+	compare(t, Query1F[position, Negate[HasAny2[enemy, frozen]]](world).Count(), 1)
+	compare(t, Query1F[position, Negate[Filters2[Has[velocity], Has[enemy]]]](world).Count(), 2)
+	compare(t, Query1F[position, Filters2[Filters2[Has[velocity], Lacks[enemy]], Lacks[frozen]]](world).Count(), 1)
+}
+
 var lastTime time.Time
 
 func TestSchedulerPhysics(t *testing.T) {
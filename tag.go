@@ -0,0 +1,30 @@
+package ecs
+
+import "math"
+
+// Tags are components without any data, like `type Enemy struct{}`. Zero sized component types are detected as tags automatically when they are registered.
+// Tags only exist in the archetypeMask. They are left out of the archetype's component list, so allocating, moving and deleting entities never touches them.
+// Every archetype with a tag shares one zero sized list, so views and reads still get a non-nil pointer.
+
+var tagComponents componentSet
+
+func isTag(compId CompId) bool {
+	return tagComponents.has(compId)
+}
+
+// Returns the mask with every tag removed
+func (m archetypeMask) withoutTags() archetypeMask {
+	return m.bitwiseClear(tagComponents.get())
+}
+
+// Implemented by every storage that can hold tags
+type tagStorage interface {
+	addTagArchetype(archetypeId)
+}
+
+// Returns a list that can be indexed by any row, because its elements are zero sized this doesn't allocate any memory
+func newTagList[T any]() *componentList[T] {
+	return &componentList[T]{
+		comp: make([]T, math.MaxInt32),
+	}
+}
@@ -0,0 +1,64 @@
+package ecs
+
+import "testing"
+
+type enemy struct{}
+type frozen struct{}
+
+func TestTagsHaveNoRows(t *testing.T) {
+	world := NewWorld()
+	id := world.Spawn(C(position{1, 1, 1}), C(enemy{}))
+
+	enemyId := NewComp[enemy]()
+	check(t, isTag(enemyId.CompId()))
+	check(t, !isTag(positionId.CompId()))
+
+	loc, _ := world.arch.Get(id)
+	lookup := world.engine.lookup[loc.archId]
+	check(t, lookup.mask.hasComponent(enemyId.CompId()))
+	compare(t, len(lookup.components), 1) // Only position has a column
+
+	_, ok := Read[enemy](world, id)
+	check(t, ok)
+	check(t, ReadPtr[enemy](world, id) != nil)
+
+	// Moving and deleting still keeps the tag in the mask
+	world.Write(id, C(velocity{}))
+	_, ok = Read[enemy](world, id)
+	check(t, ok)
+	DeleteComponent(world, id, C(enemy{}))
+	_, ok = Read[enemy](world, id)
+	check(t, !ok)
+	_, ok = Read[position](world, id)
+	check(t, ok)
+}
+
+func TestTagQueries(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), C(enemy{}))
+	b := world.Spawn(C(position{2, 2, 2}), C(enemy{}), C(frozen{}))
+	c := world.Spawn(C(position{3, 3, 3}))
+
+	query := Query2[position, enemy](world)
+	compare(t, query.Count(), 2)
+	count := 0
+	query.MapId(func(id Id, p *position, e *enemy) {
+		check(t, e != nil)
+		check(t, id == a || id == b)
+		count++
+	})
+	compare(t, count, 2)
+
+	_, e := query.Read(c)
+	check(t, e == nil)
+
+	compare(t, Query1[position](world, With(enemy{})).Count(), 2)
+	compare(t, Query1[position](world, Without(frozen{})).Count(), 2)
+
+	// Holes in archetypes with tags are cleaned up like any other archetype
+	Delete(world, a)
+	world.CleanupHoles()
+	p, e := query.Read(b)
+	compare(t, *p, position{2, 2, 2})
+	check(t, e != nil)
+}
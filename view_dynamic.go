@@ -0,0 +1,224 @@
+package ecs
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Represents a view over a list of components that was chosen at runtime, rather than through generics.
+// This is useful for things like inspectors, scripting bridges, and serializers.
+// Components are accessed by their index in the list that the view was created with.
+type DynamicView struct {
+	world    *World
+	filter   filterList
+	comps    []CompId
+	storages []storage
+	types    []reflect.Type
+
+	columns     []dynamicColumn // Reused between calls to MapId
+	readColumns []dynamicColumn // Reused between calls to Read, separate from columns so that reads can't move the columns of a MapId that is running
+}
+
+type dynamicColumn struct {
+	base   unsafe.Pointer // nil if the archetype doesn't have this component
+	stride uintptr
+	sparse sparseStorage // Set for sparse components, which are looked up by id rather than by column
+}
+
+// Creates a DynamicView for the specified world over the list of components.
+// Filters work the same as they do for the QueryN functions, so you can use Optional to include components that might be missing.
+// You can get the CompId of a static component with NewComp[T]().CompId(), or from a DynamicComp with its CompId() method
+func QueryDynamic(world *World, comps []CompId, filters ...Filter) *DynamicView {
+	comps = append([]CompId(nil), comps...) // Copy, because the filters are allowed to modify the list
+
+	storages := make([]storage, len(comps))
+	types := make([]reflect.Type, len(comps))
+	for i, compId := range comps {
+		storages[i] = world.engine.getStorage(compId)
+		types[i] = storages[i].elemType()
+	}
+
+	filterList := newFilterList(append([]CompId(nil), comps...), filters...)
+	filterList.regenerate(world)
+
+	return &DynamicView{
+		world:    world,
+		filter:   filterList,
+		comps:    comps,
+		storages: storages,
+		types:    types,
+	}
+}
+
+// Returns the list of components that this view was created with
+func (v *DynamicView) Comps() []CompId {
+	return v.comps
+}
+
+func (v *DynamicView) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *DynamicView) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Counts the number of entities that match this query
+func (v *DynamicView) Count() int {
+	v.filter.regenerate(v.world)
+
+	total := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		total += v.filter.countArchetype(v.world.engine, lookup)
+	}
+	return total
+}
+
+// Loads the columns of every component for the archetype into the supplied list
+func (v *DynamicView) loadColumns(columns []dynamicColumn, archId archetypeId) {
+	for i := range v.storages {
+		if isSparse(v.comps[i]) {
+			columns[i] = dynamicColumn{
+				sparse: v.storages[i].(sparseStorage),
+			}
+			continue
+		}
+
+		base, ok := v.storages[i].column(archId)
+		if !ok {
+			base = nil
+		}
+		columns[i] = dynamicColumn{
+			base:   base,
+			stride: v.types[i].Size(),
+		}
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters.
+// The row is only valid for the duration of the lambda call.
+func (v *DynamicView) MapId(lambda func(id Id, row DynamicRow)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	// Note: The columns are taken while they are in use, so that nested calls get their own
+	columns := v.columns
+	v.columns = nil
+	if columns == nil {
+		columns = make([]dynamicColumn, len(v.comps))
+	}
+	defer func() { v.columns = columns }()
+
+	sparse := v.filter.hasSparse()
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		v.loadColumns(columns, archId)
+
+		for idx := range ids {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+			if sparse && !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+				continue
+			}
+
+			lambda(ids[idx], DynamicRow{v, columns, ids[idx], idx})
+		}
+	}
+}
+
+// Reads the components of the entity at the specified id.
+// Like ViewN.Read, this ignores the filter list and will return false if the entity doesn't exist.
+// The row is only valid until the next call to Read on this view, or until the world changes. Calling Read inside of MapId doesn't affect the rows that MapId passes to its lambda.
+func (v *DynamicView) Read(id Id) (DynamicRow, bool) {
+	if id == InvalidEntity {
+		return DynamicRow{}, false
+	}
+
+	loc, ok := v.world.arch.Get(id)
+	if !ok {
+		return DynamicRow{}, false
+	}
+
+	if v.readColumns == nil {
+		v.readColumns = make([]dynamicColumn, len(v.comps))
+	}
+	v.loadColumns(v.readColumns, loc.archId)
+	return DynamicRow{v, v.readColumns, id, int(loc.index)}, true
+}
+
+// --------------------------------------------------------------------------------
+// - DynamicRow
+// --------------------------------------------------------------------------------
+
+// Provides access to the components of a single entity in a DynamicView. Components are accessed by their index in the view's component list
+type DynamicRow struct {
+	view    *DynamicView
+	columns []dynamicColumn // The columns of the entity's archetype
+	id      Id
+	index   int
+}
+
+// Returns true if the entity has the component at index i. This can only be false for Optional components
+func (r DynamicRow) Has(i int) bool {
+	return r.Ptr(i) != nil
+}
+
+// Returns a pointer to the component at index i, or nil if the entity doesn't have it
+func (r DynamicRow) Ptr(i int) unsafe.Pointer {
+	col := r.columns[i]
+	if col.sparse != nil {
+		return col.sparse.sparsePtr(r.id)
+	}
+	if col.base == nil {
+		return nil
+	}
+	return unsafe.Add(col.base, uintptr(r.index)*col.stride)
+}
+
+// Returns the type of the component at index i. Dynamic components without fields are byte arrays
+func (r DynamicRow) Type(i int) reflect.Type {
+	return r.view.types[i]
+}
+
+// Returns an addressable reflect.Value of the component at index i, or the zero Value if the entity doesn't have it
+func (r DynamicRow) Value(i int) reflect.Value {
+	ptr := r.Ptr(i)
+	if ptr == nil {
+		return reflect.Value{}
+	}
+	return reflect.NewAt(r.view.types[i], ptr).Elem()
+}
+
+// Returns the raw bytes of the component at index i, or nil if the entity doesn't have it.
+// This is mostly useful for dynamic components, be careful modifying static components that contain pointers this way
+func (r DynamicRow) Bytes(i int) []byte {
+	ptr := r.Ptr(i)
+	if ptr == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(ptr), r.view.types[i].Size())
+}
+
+// Returns a typed pointer to the component at index i, or nil if the entity doesn't have it.
+// Panics if T isn't the type stored for that component
+func DynamicGet[T any](r DynamicRow, i int) *T {
+	if r.view.types[i] != reflect.TypeFor[T]() {
+		panic(fmt.Sprintf("ecs: DynamicGet type %v doesn't match component type %v", reflect.TypeFor[T](), r.view.types[i]))
+	}
+	return (*T)(r.Ptr(i))
+}
@@ -0,0 +1,132 @@
+package ecs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryDynamic(t *testing.T) {
+	world := NewWorld()
+
+	a := world.Spawn(C(position{1, 1, 1}), C(velocity{2, 2, 2}), dynRaw.With([]byte{1, 2, 3}))
+	b := world.Spawn(C(position{3, 3, 3}), dynRaw.With([]byte{4, 5, 6}))
+	world.Spawn(C(velocity{5, 5, 5}))
+
+	comps := []CompId{compIdOf[position](), dynRaw.CompId(), compIdOf[velocity]()}
+	query := QueryDynamic(world, comps, Optional(velocity{}))
+	compare(t, query.Count(), 2)
+
+	seen := make(map[Id]bool)
+	query.MapId(func(id Id, row DynamicRow) {
+		seen[id] = true
+
+		pos := DynamicGet[position](row, 0)
+		p, _ := Read[position](world, id)
+		compare(t, *pos, p)
+
+		raw, _ := ReadDynamic(world, id, dynRaw)
+		compare(t, string(row.Bytes(1)), string(raw))
+		compare(t, row.Type(1), reflect.TypeFor[[3]byte]())
+
+		switch id {
+		case a:
+			check(t, row.Has(2))
+			compare(t, row.Value(2).Interface().(velocity), velocity{2, 2, 2})
+		case b:
+			check(t, !row.Has(2))
+			check(t, row.Ptr(2) == nil)
+			check(t, !row.Value(2).IsValid())
+		}
+
+		// Modify through every kind of accessor
+		pos.x += 10
+		row.Bytes(1)[0] = 9
+	})
+	check(t, seen[a])
+	check(t, seen[b])
+
+	p, _ := Read[position](world, a)
+	compare(t, p, position{11, 1, 1})
+	raw, _ := ReadDynamic(world, b, dynRaw)
+	compare(t, string(raw), string([]byte{9, 5, 6}))
+
+	row, ok := query.Read(a)
+	check(t, ok)
+	row.Value(0).Set(reflect.ValueOf(position{11, 20, 1}))
+	p, _ = Read[position](world, a)
+	compare(t, p, position{11, 20, 1})
+
+	func() {
+		defer func() { check(t, recover() != nil) }()
+		DynamicGet[velocity](row, 0) // Wrong type
+	}()
+}
+
+func TestQueryDynamicFilters(t *testing.T) {
+	world := NewWorld()
+
+	world.Spawn(C(position{}), C(velocity{}))
+	world.Spawn(C(position{}))
+	world.Spawn(C(position{}), dynHealth.With(healthBytes(1, 1)))
+
+	compare(t, QueryDynamic(world, []CompId{compIdOf[position]()}).Count(), 3)
+	compare(t, QueryDynamic(world, []CompId{compIdOf[position]()}, Without(velocity{})).Count(), 2)
+	compare(t, QueryDynamic(world, []CompId{compIdOf[position]()}, With(dynHealth)).Count(), 1)
+}
+
+func TestQueryDynamicZeroSized(t *testing.T) {
+	marker := RegisterDynamicComp("test.marker", 0)
+
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), marker)
+	b := world.Spawn(C(position{2, 2, 2}))
+
+	_, ok := ReadDynamic(world, a, marker)
+	check(t, ok)
+
+	query := QueryDynamic(world, []CompId{compIdOf[position](), marker.CompId()}, Optional(marker.CompId()))
+	compare(t, query.Count(), 2)
+	query.MapId(func(id Id, row DynamicRow) {
+		switch id {
+		case a:
+			check(t, row.Has(1))
+			compare(t, len(row.Bytes(1)), 0)
+		case b:
+			check(t, !row.Has(1))
+		}
+	})
+
+	row, ok := query.Read(a)
+	check(t, ok)
+	check(t, row.Has(1))
+}
+
+func TestQueryDynamicReadInsideMapId(t *testing.T) {
+	world := NewWorld()
+	a := world.Spawn(C(position{1, 1, 1}), C(velocity{1, 1, 1}))
+	b := world.Spawn(C(position{2, 2, 2}), C(velocity{2, 2, 2}), C(radius{2}), dynRaw.With([]byte{1, 2, 3}))
+	c := world.Spawn(C(position{3, 3, 3}), C(velocity{3, 3, 3}), C(acceleration{}))
+
+	query := QueryDynamic(world, []CompId{compIdOf[position](), compIdOf[velocity]()})
+	visited := 0
+	query.MapId(func(id Id, row DynamicRow) {
+		// Reads and nested iterations load the columns of other archetypes, which must not change this row
+		other, ok := query.Read(b)
+		check(t, ok)
+		compare(t, *DynamicGet[position](other, 0), position{2, 2, 2})
+		query.MapId(func(Id, DynamicRow) {})
+
+		p, _ := Read[position](world, id)
+		compare(t, *DynamicGet[position](row, 0), p)
+		v, _ := Read[velocity](world, id)
+		compare(t, *DynamicGet[velocity](row, 1), v)
+		visited++
+	})
+	compare(t, visited, 3)
+
+	row, ok := query.Read(a)
+	check(t, ok)
+	compare(t, *DynamicGet[position](row, 0), position{1, 1, 1})
+	row, _ = query.Read(c)
+	compare(t, *DynamicGet[velocity](row, 1), velocity{3, 3, 3})
+}
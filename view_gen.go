@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"iter"
 	"runtime"
 	"sync"
 )
@@ -15,26 +16,43 @@ import (
 type View1[A any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View1F if the injected view needs filters
 func (v *View1[A]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
 	return Query1[A](world)
 }
 
+// A View1 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View1F[A any, QF QueryFilter] struct {
+	View1[A]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View1F[A, QF]) Initialize(world *World) any {
+	return Query1F[A, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query1F[A any, QF QueryFilter](world *World) *View1F[A, QF] {
+	var f QF
+	return &View1F[A, QF]{
+		View1: *Query1[A](world, f.Filters()...),
+	}
+}
+
 // Creates a View for the specified world with the specified component filters.
 func Query1[A any](world *World, filters ...Filter) *View1[A] {
 
 	storageA := getStorage[A](world.engine)
 
-	var AA A
-
 	comps := []CompId{
 
-		name(AA),
+		compIdOf[A](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
@@ -42,14 +60,20 @@ func Query1[A any](world *World, filters ...Filter) *View1[A] {
 	v := &View1[A]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil,
 
 		storageA: storageA,
 	}
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View1[A]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
 func (v *View1[A]) Read(id Id) *A {
@@ -65,16 +89,58 @@ func (v *View1[A]) Read(id Id) *A {
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
+	retA := v.storageA.get(loc, id)
+
+	return retA
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View1[A]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View1[A]) Get(id Id) (*A, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, false
 	}
 
-	return retA
+	retA := v.storageA.get(loc, id)
+
+	return retA, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View1[A]) First() (Id, *A, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+
+	return id, retA, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View1[A]) Single() (Id, *A, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, ErrNoMatch
+	case 1:
+		id, retA, _ := v.First()
+		return id, retA, nil
+	default:
+		return InvalidEntity, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
@@ -88,7 +154,7 @@ func (v *View1[A]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
@@ -96,10 +162,13 @@ func (v *View1[A]) Count() int {
 // Maps the lambda function across every entity which matched the specified filters.
 func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -121,6 +190,25 @@ func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 		}
 
 		retA = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				lambda(ids[idx], retA)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -134,11 +222,266 @@ func (v *View1[A]) MapId(lambda func(id Id, a *A)) {
 	}
 }
 
+// Holds pointers to the components of a single entity, this is yielded by View1.All
+type Row1[A any] struct {
+	A *A
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View1[A]) All() iter.Seq2[Id, Row1[A]] {
+	return func(yield func(Id, Row1[A]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var row Row1[A]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+
+			row = Row1[A]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View1[A]) MapIds(ids []Id, lambda func(id Id, a *A)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+
+			retA = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+		}
+		lambda(row.id, retA)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View1[A]) MapIdOrdered(order Order, lambda func(id Id, a *A)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+
+			retA = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+		}
+		lambda(row.id, retA)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View1[A]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+
+		retA = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				lambda(ids[idx], retA)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				lambda(ids[idx], retA)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
 // Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
 func (v *View1[A]) MapIdParallel(lambda func(id Id, a *A)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
 
 	// 1. Calculate work
 	// 2. Calculate number of threads to execute with
@@ -190,6 +533,25 @@ func (v *View1[A]) MapIdParallel(lambda func(id Id, a *A)) {
 				}
 
 				var retA *A
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -242,11 +604,14 @@ func (v *View1[A]) MapIdParallel(lambda func(id Id, a *A)) {
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
 			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -258,6 +623,56 @@ func (v *View1[A]) MapIdParallel(lambda func(id Id, a *A)) {
 	waitGroup.Wait()
 }
 
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View1[A]) MapChunks(lambda func(ids []Id, a []A)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
 func (v *View1[A]) MapSlices(lambda func(id []Id, a []A)) {
 	v.filter.regenerate(v.world)
@@ -300,30 +715,46 @@ func (v *View1[A]) MapSlices(lambda func(id []Id, a []A)) {
 type View2[A, B any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View2F if the injected view needs filters
 func (v *View2[A, B]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
 	return Query2[A, B](world)
 }
 
+// A View2 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View2F[A, B any, QF QueryFilter] struct {
+	View2[A, B]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View2F[A, B, QF]) Initialize(world *World) any {
+	return Query2F[A, B, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query2F[A, B any, QF QueryFilter](world *World) *View2F[A, B, QF] {
+	var f QF
+	return &View2F[A, B, QF]{
+		View2: *Query2[A, B](world, f.Filters()...),
+	}
+}
+
 // Creates a View for the specified world with the specified component filters.
 func Query2[A, B any](world *World, filters ...Filter) *View2[A, B] {
 
 	storageA := getStorage[A](world.engine)
 	storageB := getStorage[B](world.engine)
 
-	var AA A
-	var BB B
-
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
+		compIdOf[A](),
+		compIdOf[B](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
@@ -331,6 +762,7 @@ func Query2[A, B any](world *World, filters ...Filter) *View2[A, B] {
 	v := &View2[A, B]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -338,8 +770,13 @@ func Query2[A, B any](world *World, filters ...Filter) *View2[A, B] {
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View2[A, B]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
 func (v *View2[A, B]) Read(id Id) (*A, *B) {
@@ -355,21 +792,61 @@ func (v *View2[A, B]) Read(id Id) (*A, *B) {
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+
+	return retA, retB
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View2[A, B]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View2[A, B]) Get(id Id) (*A, *B, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, false
 	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+
+	return retA, retB, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View2[A, B]) First() (Id, *A, *B, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, false
 	}
 
-	return retA, retB
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+
+	return id, retA, retB, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View2[A, B]) Single() (Id, *A, *B, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, _ := v.First()
+		return id, retA, retB, nil
+	default:
+		return InvalidEntity, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
@@ -383,7 +860,7 @@ func (v *View2[A, B]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
@@ -391,14 +868,18 @@ func (v *View2[A, B]) Count() int {
 // Maps the lambda function across every entity which matched the specified filters.
 func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -426,6 +907,30 @@ func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 
 		retA = nil
 		retB = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -442,55 +947,382 @@ func (v *View2[A, B]) MapId(lambda func(id Id, a *A, b *B)) {
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View2[A, B]) MapIdParallel(lambda func(id Id, a *A, b *B)) {
-	v.filter.regenerate(v.world)
-
-	var sliceA *componentList[A]
+// Holds pointers to the components of a single entity, this is yielded by View2.All
+type Row2[A, B any] struct {
+	A *A
+	B *B
+}
 
-	var sliceB *componentList[B]
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View2[A, B]) All() iter.Seq2[Id, Row2[A, B]] {
+	return func(yield func(Id, Row2[A, B]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
 
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
 
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
-		}
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
+		var row Row2[A, B]
+		for _, archId := range v.filter.archIds {
 
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
-	}
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
 
-	// 2. Calculate number of threads to execute with
-	numThreads := runtime.NumCPU()
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
 
-	// Ensure that the number of threads we plan to use is <= total amount of work
-	numThreads = min(totalWork, numThreads)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
 
-	var waitGroup sync.WaitGroup
+			row = Row2[A, B]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
 
-	type workItem struct {
-		ids []Id
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
 
-		compA []A
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
 
-		compB []B
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
 	}
-	workChannel := make(chan workItem)
+}
 
-	for i := 0; i < numThreads; i++ {
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View2[A, B]) MapIds(ids []Id, lambda func(id Id, a *A, b *B)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+
+			retA = nil
+			retB = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View2[A, B]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+
+			retA = nil
+			retB = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View2[A, B]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+
+		retA = nil
+		retB = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				lambda(ids[idx], retA, retB)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View2[A, B]) MapIdParallel(lambda func(id Id, a *A, b *B)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
+	}
+
+	// 2. Calculate number of threads to execute with
+	numThreads := runtime.NumCPU()
+
+	// Ensure that the number of threads we plan to use is <= total amount of work
+	numThreads = min(totalWork, numThreads)
+
+	var waitGroup sync.WaitGroup
+
+	type workItem struct {
+		ids []Id
+
+		compA []A
+
+		compB []B
+	}
+	workChannel := make(chan workItem)
+
+	for i := 0; i < numThreads; i++ {
 		waitGroup.Add(1)
 		go func() {
 			defer waitGroup.Done()
@@ -503,6 +1335,30 @@ func (v *View2[A, B]) MapIdParallel(lambda func(id Id, a *A, b *B)) {
 
 				var retA *A
 				var retB *B
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -565,13 +1421,17 @@ func (v *View2[A, B]) MapIdParallel(lambda func(id Id, a *A, b *B)) {
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
 			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -583,6 +1443,61 @@ func (v *View2[A, B]) MapIdParallel(lambda func(id Id, a *A, b *B)) {
 	waitGroup.Wait()
 }
 
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View2[A, B]) MapChunks(lambda func(ids []Id, a []A, b []B)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
 func (v *View2[A, B]) MapSlices(lambda func(id []Id, a []A, b []B)) {
 	v.filter.regenerate(v.world)
@@ -631,6 +1546,7 @@ func (v *View2[A, B]) MapSlices(lambda func(id []Id, a []A, b []B)) {
 type View3[A, B, C any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -638,11 +1554,29 @@ type View3[A, B, C any] struct {
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View3F if the injected view needs filters
 func (v *View3[A, B, C]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
 	return Query3[A, B, C](world)
 }
 
+// A View3 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View3F[A, B, C any, QF QueryFilter] struct {
+	View3[A, B, C]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View3F[A, B, C, QF]) Initialize(world *World) any {
+	return Query3F[A, B, C, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query3F[A, B, C any, QF QueryFilter](world *World) *View3F[A, B, C, QF] {
+	var f QF
+	return &View3F[A, B, C, QF]{
+		View3: *Query3[A, B, C](world, f.Filters()...),
+	}
+}
+
 // Creates a View for the specified world with the specified component filters.
 func Query3[A, B, C any](world *World, filters ...Filter) *View3[A, B, C] {
 
@@ -650,15 +1584,11 @@ func Query3[A, B, C any](world *World, filters ...Filter) *View3[A, B, C] {
 	storageB := getStorage[B](world.engine)
 	storageC := getStorage[C](world.engine)
 
-	var AA A
-	var BB B
-	var CC C
-
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
@@ -666,6 +1596,7 @@ func Query3[A, B, C any](world *World, filters ...Filter) *View3[A, B, C] {
 	v := &View3[A, B, C]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -674,8 +1605,13 @@ func Query3[A, B, C any](world *World, filters ...Filter) *View3[A, B, C] {
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View3[A, B, C]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
 func (v *View3[A, B, C]) Read(id Id) (*A, *B, *C) {
@@ -691,26 +1627,64 @@ func (v *View3[A, B, C]) Read(id Id) (*A, *B, *C) {
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
+	return retA, retB, retC
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View3[A, B, C]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View3[A, B, C]) Get(id Id) (*A, *B, *C, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, false
 	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+
+	return retA, retB, retC, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View3[A, B, C]) First() (Id, *A, *B, *C, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, false
 	}
 
-	return retA, retB, retC
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+
+	return id, retA, retB, retC, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View3[A, B, C]) Single() (Id, *A, *B, *C, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, _ := v.First()
+		return id, retA, retB, retC, nil
+	default:
+		return InvalidEntity, nil, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
@@ -724,7 +1698,7 @@ func (v *View3[A, B, C]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
@@ -732,18 +1706,23 @@ func (v *View3[A, B, C]) Count() int {
 // Maps the lambda function across every entity which matched the specified filters.
 func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -777,6 +1756,35 @@ func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 		retA = nil
 		retB = nil
 		retC = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -796,44 +1804,443 @@ func (v *View3[A, B, C]) MapId(lambda func(id Id, a *A, b *B, c *C)) {
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View3[A, B, C]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C)) {
-	v.filter.regenerate(v.world)
+// Holds pointers to the components of a single entity, this is yielded by View3.All
+type Row3[A, B, C any] struct {
+	A *A
+	B *B
+	C *C
+}
 
-	var sliceA *componentList[A]
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View3[A, B, C]) All() iter.Seq2[Id, Row3[A, B, C]] {
+	return func(yield func(Id, Row3[A, B, C]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
 
-	var sliceB *componentList[B]
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
 
-	var sliceC *componentList[C]
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
 
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
 
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
-		}
+		var row Row3[A, B, C]
+		for _, archId := range v.filter.archIds {
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
 
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
-	}
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
 
-	// 2. Calculate number of threads to execute with
-	numThreads := runtime.NumCPU()
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
 
-	// Ensure that the number of threads we plan to use is <= total amount of work
-	numThreads = min(totalWork, numThreads)
+			row = Row3[A, B, C]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View3[A, B, C]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View3[A, B, C]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View3[A, B, C]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				lambda(ids[idx], retA, retB, retC)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View3[A, B, C]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
+	}
+
+	// 2. Calculate number of threads to execute with
+	numThreads := runtime.NumCPU()
+
+	// Ensure that the number of threads we plan to use is <= total amount of work
+	numThreads = min(totalWork, numThreads)
 
 	var waitGroup sync.WaitGroup
 
@@ -862,6 +2269,35 @@ func (v *View3[A, B, C]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C)) {
 				var retA *A
 				var retB *B
 				var retC *C
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -934,15 +2370,20 @@ func (v *View3[A, B, C]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C)) {
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
 			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -954,6 +2395,66 @@ func (v *View3[A, B, C]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C)) {
 	waitGroup.Wait()
 }
 
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View3[A, B, C]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+	var compC []C
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
 func (v *View3[A, B, C]) MapSlices(lambda func(id []Id, a []A, b []B, c []C)) {
 	v.filter.regenerate(v.world)
@@ -1008,6 +2509,7 @@ func (v *View3[A, B, C]) MapSlices(lambda func(id []Id, a []A, b []B, c []C)) {
 type View4[A, B, C, D any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -1016,11 +2518,29 @@ type View4[A, B, C, D any] struct {
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View4F if the injected view needs filters
 func (v *View4[A, B, C, D]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
 	return Query4[A, B, C, D](world)
 }
 
+// A View4 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View4F[A, B, C, D any, QF QueryFilter] struct {
+	View4[A, B, C, D]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View4F[A, B, C, D, QF]) Initialize(world *World) any {
+	return Query4F[A, B, C, D, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query4F[A, B, C, D any, QF QueryFilter](world *World) *View4F[A, B, C, D, QF] {
+	var f QF
+	return &View4F[A, B, C, D, QF]{
+		View4: *Query4[A, B, C, D](world, f.Filters()...),
+	}
+}
+
 // Creates a View for the specified world with the specified component filters.
 func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D] {
 
@@ -1029,17 +2549,12 @@ func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D]
 	storageC := getStorage[C](world.engine)
 	storageD := getStorage[D](world.engine)
 
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
@@ -1047,6 +2562,7 @@ func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D]
 	v := &View4[A, B, C, D]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -1056,8 +2572,13 @@ func Query4[A, B, C, D any](world *World, filters ...Filter) *View4[A, B, C, D]
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View4[A, B, C, D]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
 func (v *View4[A, B, C, D]) Read(id Id) (*A, *B, *C, *D) {
@@ -1073,45 +2594,81 @@ func (v *View4[A, B, C, D]) Read(id Id) (*A, *B, *C, *D) {
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
 
 	return retA, retB, retC, retD
 }
 
-// Counts the number of entities that match this query
-func (v *View4[A, B, C, D]) Count() int {
-	v.filter.regenerate(v.world)
+// Returns true if the entity exists and matches the view's filters
+func (v *View4[A, B, C, D]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
 
-	total := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View4[A, B, C, D]) Get(id Id) (*A, *B, *C, *D, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+
+	return retA, retB, retC, retD, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View4[A, B, C, D]) First() (Id, *A, *B, *C, *D, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+
+	return id, retA, retB, retC, retD, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View4[A, B, C, D]) Single() (Id, *A, *B, *C, *D, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, _ := v.First()
+		return id, retA, retB, retC, retD, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, ErrMultipleMatches
+	}
+}
+
+// Counts the number of entities that match this query
+func (v *View4[A, B, C, D]) Count() int {
+	v.filter.regenerate(v.world)
+
+	total := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
@@ -1119,22 +2676,28 @@ func (v *View4[A, B, C, D]) Count() int {
 // Maps the lambda function across every entity which matched the specified filters.
 func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -1174,6 +2737,40 @@ func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 		retB = nil
 		retC = nil
 		retD = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -1196,17 +2793,488 @@ func (v *View4[A, B, C, D]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 	}
 }
 
+// Holds pointers to the components of a single entity, this is yielded by View4.All
+type Row4[A, B, C, D any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View4[A, B, C, D]) All() iter.Seq2[Id, Row4[A, B, C, D]] {
+	return func(yield func(Id, Row4[A, B, C, D]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var row Row4[A, B, C, D]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+
+			row = Row4[A, B, C, D]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View4[A, B, C, D]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View4[A, B, C, D]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View4[A, B, C, D]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
 // Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
 func (v *View4[A, B, C, D]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
 
 	// 1. Calculate work
 	// 2. Calculate number of threads to execute with
@@ -1267,6 +3335,40 @@ func (v *View4[A, B, C, D]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d
 				var retB *B
 				var retC *C
 				var retD *D
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -1349,17 +3451,23 @@ func (v *View4[A, B, C, D]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
 			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -1371,28 +3479,93 @@ func (v *View4[A, B, C, D]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d
 	waitGroup.Wait()
 }
 
-// Deprecated: This API is a tentative alternative way to map
-func (v *View4[A, B, C, D]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D)) {
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View4[A, B, C, D]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
-	id := make([][]Id, 0)
-
-	sliceListA := make([][]A, 0)
-	sliceListB := make([][]B, 0)
-	sliceListC := make([][]C, 0)
-	sliceListD := make([][]D, 0)
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
 
 	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
 
-		sliceA, ok := v.storageA.slice.Get(archId)
-		if !ok {
-			continue
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
 		}
-		sliceB, ok := v.storageB.slice.Get(archId)
-		if !ok {
-			continue
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
 		}
-		sliceC, ok := v.storageC.slice.Get(archId)
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
+// Deprecated: This API is a tentative alternative way to map
+func (v *View4[A, B, C, D]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D)) {
+	v.filter.regenerate(v.world)
+
+	id := make([][]Id, 0)
+
+	sliceListA := make([][]A, 0)
+	sliceListB := make([][]B, 0)
+	sliceListC := make([][]C, 0)
+	sliceListD := make([][]D, 0)
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, ok := v.storageA.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceB, ok := v.storageB.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceC, ok := v.storageC.slice.Get(archId)
 		if !ok {
 			continue
 		}
@@ -1431,6 +3604,7 @@ func (v *View4[A, B, C, D]) MapSlices(lambda func(id []Id, a []A, b []B, c []C,
 type View5[A, B, C, D, E any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -1440,11 +3614,29 @@ type View5[A, B, C, D, E any] struct {
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View5F if the injected view needs filters
 func (v *View5[A, B, C, D, E]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
 	return Query5[A, B, C, D, E](world)
 }
 
+// A View5 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View5F[A, B, C, D, E any, QF QueryFilter] struct {
+	View5[A, B, C, D, E]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View5F[A, B, C, D, E, QF]) Initialize(world *World) any {
+	return Query5F[A, B, C, D, E, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query5F[A, B, C, D, E any, QF QueryFilter](world *World) *View5F[A, B, C, D, E, QF] {
+	var f QF
+	return &View5F[A, B, C, D, E, QF]{
+		View5: *Query5[A, B, C, D, E](world, f.Filters()...),
+	}
+}
+
 // Creates a View for the specified world with the specified component filters.
 func Query5[A, B, C, D, E any](world *World, filters ...Filter) *View5[A, B, C, D, E] {
 
@@ -1454,19 +3646,13 @@ func Query5[A, B, C, D, E any](world *World, filters ...Filter) *View5[A, B, C,
 	storageD := getStorage[D](world.engine)
 	storageE := getStorage[E](world.engine)
 
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
@@ -1474,6 +3660,7 @@ func Query5[A, B, C, D, E any](world *World, filters ...Filter) *View5[A, B, C,
 	v := &View5[A, B, C, D, E]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -1484,8 +3671,13 @@ func Query5[A, B, C, D, E any](world *World, filters ...Filter) *View5[A, B, C,
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View5[A, B, C, D, E]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
 func (v *View5[A, B, C, D, E]) Read(id Id) (*A, *B, *C, *D, *E) {
@@ -1501,36 +3693,70 @@ func (v *View5[A, B, C, D, E]) Read(id Id) (*A, *B, *C, *D, *E) {
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
+	return retA, retB, retC, retD, retE
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View5[A, B, C, D, E]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View5[A, B, C, D, E]) Get(id Id) (*A, *B, *C, *D, *E, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, false
 	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+
+	return retA, retB, retC, retD, retE, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View5[A, B, C, D, E]) First() (Id, *A, *B, *C, *D, *E, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, false
 	}
 
-	return retA, retB, retC, retD, retE
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View5[A, B, C, D, E]) Single() (Id, *A, *B, *C, *D, *E, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, _ := v.First()
+		return id, retA, retB, retC, retD, retE, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
@@ -1544,7 +3770,7 @@ func (v *View5[A, B, C, D, E]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
@@ -1552,26 +3778,33 @@ func (v *View5[A, B, C, D, E]) Count() int {
 // Maps the lambda function across every entity which matched the specified filters.
 func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -1617,6 +3850,45 @@ func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D,
 		retC = nil
 		retD = nil
 		retE = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -1642,439 +3914,437 @@ func (v *View5[A, B, C, D, E]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D,
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View5[A, B, C, D, E]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
-	v.filter.regenerate(v.world)
-
-	var sliceA *componentList[A]
-
-	var sliceB *componentList[B]
-
-	var sliceC *componentList[C]
-
-	var sliceD *componentList[D]
-
-	var sliceE *componentList[E]
-
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
-
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
-		}
-
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
-
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
-	}
-
-	// 2. Calculate number of threads to execute with
-	numThreads := runtime.NumCPU()
-
-	// Ensure that the number of threads we plan to use is <= total amount of work
-	numThreads = min(totalWork, numThreads)
-
-	var waitGroup sync.WaitGroup
-
-	type workItem struct {
-		ids []Id
-
-		compA []A
-
-		compB []B
-
-		compC []C
-
-		compD []D
-
-		compE []E
-	}
-	workChannel := make(chan workItem)
+// Holds pointers to the components of a single entity, this is yielded by View5.All
+type Row5[A, B, C, D, E any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+}
 
-	for i := 0; i < numThreads; i++ {
-		waitGroup.Add(1)
-		go func() {
-			defer waitGroup.Done()
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View5[A, B, C, D, E]) All() iter.Seq2[Id, Row5[A, B, C, D, E]] {
+	return func(yield func(Id, Row5[A, B, C, D, E]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var row Row5[A, B, C, D, E]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
 
-			for {
-				work, ok := <-workChannel
-				if !ok {
-					return
-				}
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
 
-				var retA *A
-				var retB *B
-				var retC *C
-				var retD *D
-				var retE *E
-				for idx := range work.ids {
-					if work.ids[idx] == InvalidEntity {
+			row = Row5[A, B, C, D, E]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
 						continue
 					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
 
-					if work.compA != nil {
-						retA = &work.compA[idx]
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
 					}
-					if work.compB != nil {
-						retB = &work.compB[idx]
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
 					}
-					if work.compC != nil {
-						retC = &work.compC[idx]
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
 					}
-					if work.compD != nil {
-						retD = &work.compD[idx]
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
 					}
-					if work.compE != nil {
-						retE = &work.compE[idx]
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
 					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE)
 				}
+				continue
 			}
-		}()
-	}
 
-	// 3. Greedy divide work among N threads
-	// Simple algorithm:
-	// a. Find an evenly balanced distribution per thread
-	// b. Generate all work until it gets consumed
-	workPerThread := totalWork / numThreads
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
 
-	// Generate
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
 
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View5[A, B, C, D, E]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
 	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
+	var sliceB *componentList[B]
 	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
+	var sliceC *componentList[C]
 	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
+	var sliceD *componentList[D]
 	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
+	var sliceE *componentList[E]
 	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
 
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
 		}
-		ids := lookup.id
 
-		sliceA, _ = v.storageA.slice.Get(archId)
-		sliceB, _ = v.storageB.slice.Get(archId)
-		sliceC, _ = v.storageC.slice.Get(archId)
-		sliceD, _ = v.storageD.slice.Get(archId)
-		sliceE, _ = v.storageE.slice.Get(archId)
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-		compA = nil
-		if sliceA != nil {
-			compA = sliceA.comp
+		if compA != nil {
+			retA = &compA[idx]
 		}
-		compB = nil
-		if sliceB != nil {
-			compB = sliceB.comp
+		if compB != nil {
+			retB = &compB[idx]
 		}
-		compC = nil
-		if sliceC != nil {
-			compC = sliceC.comp
+		if compC != nil {
+			retC = &compC[idx]
 		}
-		compD = nil
-		if sliceD != nil {
-			compD = sliceD.comp
+		if compD != nil {
+			retD = &compD[idx]
 		}
-		compE = nil
-		if sliceE != nil {
-			compE = sliceE.comp
+		if compE != nil {
+			retE = &compE[idx]
 		}
+		if sparse {
 
-		// workPerformed := 0
-
-		start := 0
-		end := 0
-		numWorkItems := (len(ids) / workPerThread) + 1
-		actualWorkPerThread := (len(ids) / numWorkItems) + 1
-		for i := 0; i < numWorkItems; i++ {
-			start = i * actualWorkPerThread
-			end = (i + 1) * actualWorkPerThread
-			if end > len(ids) {
-				end = len(ids)
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
 			}
-
-			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
-				ids: ids[start:end],
-
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
 			}
 		}
-
-		// if workPerformed != len(ids) {
-		// 	panic("wrong")
-		// }
+		lambda(row.id, retA, retB, retC, retD, retE)
 	}
-
-	close(workChannel)
-	waitGroup.Wait()
 }
 
-// Deprecated: This API is a tentative alternative way to map
-func (v *View5[A, B, C, D, E]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E)) {
-	v.filter.regenerate(v.world)
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View5[A, B, C, D, E]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
 
-	id := make([][]Id, 0)
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
-	sliceListA := make([][]A, 0)
-	sliceListB := make([][]B, 0)
-	sliceListC := make([][]C, 0)
-	sliceListD := make([][]D, 0)
-	sliceListE := make([][]E, 0)
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
-	for _, archId := range v.filter.archIds {
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
-		sliceA, ok := v.storageA.slice.Get(archId)
-		if !ok {
-			continue
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
 		}
-		sliceB, ok := v.storageB.slice.Get(archId)
-		if !ok {
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
 			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
 		}
-		sliceC, ok := v.storageC.slice.Get(archId)
-		if !ok {
-			continue
+		if compB != nil {
+			retB = &compB[idx]
 		}
-		sliceD, ok := v.storageD.slice.Get(archId)
-		if !ok {
-			continue
+		if compC != nil {
+			retC = &compC[idx]
 		}
-		sliceE, ok := v.storageE.slice.Get(archId)
-		if !ok {
-			continue
+		if compD != nil {
+			retD = &compD[idx]
 		}
-
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+		if compE != nil {
+			retE = &compE[idx]
 		}
-		// lookup, ok := v.world.engine.lookup[archId]
-		// if !ok { panic("LookupList is missing!") }
-
-		id = append(id, lookup.id)
-
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-	}
-
-	for idx := range id {
-		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx],
-		)
-	}
-}
-
-// --------------------------------------------------------------------------------
-// - View 6
-// --------------------------------------------------------------------------------
-
-// Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View6[A, B, C, D, E, F any] struct {
-	world  *World
-	filter filterList
-
-	storageA *componentStorage[A]
-	storageB *componentStorage[B]
-	storageC *componentStorage[C]
-	storageD *componentStorage[D]
-	storageE *componentStorage[E]
-	storageF *componentStorage[F]
-}
-
-// implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View6[A, B, C, D, E, F]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query6[A, B, C, D, E, F](world)
-}
-
-// Creates a View for the specified world with the specified component filters.
-func Query6[A, B, C, D, E, F any](world *World, filters ...Filter) *View6[A, B, C, D, E, F] {
-
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-
-	comps := []CompId{
-
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
-
-	v := &View6[A, B, C, D, E, F]{
-		world:  world,
-		filter: filterList,
-
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-	}
-	return v
-}
-
-// Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
-// Read will return the value if it exists, else returns nil.
-// If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View6[A, B, C, D, E, F]) Read(id Id) (*A, *B, *C, *D, *E, *F) {
-	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil
-	}
-
-	loc, ok := v.world.arch.Get(id)
-	if !ok {
-		return nil, nil, nil, nil, nil, nil
-	}
-	lookup := v.world.engine.lookup[loc.archId]
-	if lookup == nil {
-		panic("LookupList is missing!")
-	}
-	index := int(loc.index)
-
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
-	var retF *F
+		if sparse {
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
-	}
-
-	return retA, retB, retC, retD, retE, retF
-}
-
-// Counts the number of entities that match this query
-func (v *View6[A, B, C, D, E, F]) Count() int {
-	v.filter.regenerate(v.world)
-
-	total := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
 		}
-
-		total += lookup.Len()
+		lambda(row.id, retA, retB, retC, retD, retE)
 	}
-	return total
 }
 
-// Maps the lambda function across every entity which matched the specified filters.
-func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View5[A, B, C, D, E]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) bool {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
-
-	var sliceF *componentList[F]
-	var compF []F
-	var retF *F
+	sparseE := v.storageE.sparse
 
 	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
 
 		sliceA, _ = v.storageA.slice.Get(archId)
 		sliceB, _ = v.storageB.slice.Get(archId)
 		sliceC, _ = v.storageC.slice.Get(archId)
 		sliceD, _ = v.storageD.slice.Get(archId)
 		sliceE, _ = v.storageE.slice.Get(archId)
-		sliceF, _ = v.storageF.slice.Get(archId)
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
 			panic("LookupList is missing!")
 		}
-		// lookup, ok := v.world.engine.lookup[archId]
-		// if !ok { panic("LookupList is missing!") }
 		ids := lookup.id
 
-		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
-
 		compA = nil
 		if sliceA != nil {
 			compA = sliceA.comp
@@ -2095,60 +4365,111 @@ func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *
 		if sliceE != nil {
 			compE = sliceE.comp
 		}
-		compF = nil
-		if sliceF != nil {
-			compF = sliceF.comp
-		}
 
 		retA = nil
 		retB = nil
 		retC = nil
 		retD = nil
 		retE = nil
-		retF = nil
-		for idx := range ids {
-			if ids[idx] == InvalidEntity {
-				continue
-			} // Skip if its a hole
-
-			if compA != nil {
-				retA = &compA[idx]
-			}
-			if compB != nil {
-				retB = &compB[idx]
-			}
-			if compC != nil {
-				retC = &compC[idx]
-			}
-			if compD != nil {
-				retD = &compD[idx]
-			}
-			if compE != nil {
-				retE = &compE[idx]
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE)
+				cursor.visited++
 			}
-			if compF != nil {
-				retF = &compF[idx]
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE)
+				cursor.visited++
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF)
 		}
+		cursor.index = len(ids)
 	}
+
+	cursor.Reset()
+	return true
 }
 
 // Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
+func (v *View5[A, B, C, D, E]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
-
-	var sliceF *componentList[F]
+	sparseE := v.storageE.sparse
 
 	// 1. Calculate work
 	// 2. Calculate number of threads to execute with
@@ -2193,8 +4514,6 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 		compD []D
 
 		compE []E
-
-		compF []F
 	}
 	workChannel := make(chan workItem)
 
@@ -2214,7 +4533,45 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 				var retC *C
 				var retD *D
 				var retE *E
-				var retF *F
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -2235,10 +4592,7 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 					if work.compE != nil {
 						retE = &work.compE[idx]
 					}
-					if work.compF != nil {
-						retF = &work.compF[idx]
-					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF)
+					lambda(work.ids[idx], retA, retB, retC, retD, retE)
 				}
 			}
 		}()
@@ -2262,8 +4616,6 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 
 	var compE []E
 
-	var compF []F
-
 	for _, archId := range v.filter.archIds {
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -2276,7 +4628,6 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 		sliceC, _ = v.storageC.slice.Get(archId)
 		sliceD, _ = v.storageD.slice.Get(archId)
 		sliceE, _ = v.storageE.slice.Get(archId)
-		sliceF, _ = v.storageF.slice.Get(archId)
 
 		compA = nil
 		if sliceA != nil {
@@ -2298,10 +4649,6 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 		if sliceE != nil {
 			compE = sliceE.comp
 		}
-		compF = nil
-		if sliceF != nil {
-			compF = sliceF.comp
-		}
 
 		// workPerformed := 0
 
@@ -2317,21 +4664,26 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
-
-				compF: compF[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
 			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -2343,8 +4695,78 @@ func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c
 	waitGroup.Wait()
 }
 
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View5[A, B, C, D, E]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
-func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F)) {
+func (v *View5[A, B, C, D, E]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E)) {
 	v.filter.regenerate(v.world)
 
 	id := make([][]Id, 0)
@@ -2354,7 +4776,6 @@ func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c
 	sliceListC := make([][]C, 0)
 	sliceListD := make([][]D, 0)
 	sliceListE := make([][]E, 0)
-	sliceListF := make([][]F, 0)
 
 	for _, archId := range v.filter.archIds {
 
@@ -2378,10 +4799,6 @@ func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c
 		if !ok {
 			continue
 		}
-		sliceF, ok := v.storageF.slice.Get(archId)
-		if !ok {
-			continue
-		}
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -2397,24 +4814,24 @@ func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c
 		sliceListC = append(sliceListC, sliceC.comp)
 		sliceListD = append(sliceListD, sliceD.comp)
 		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
 	}
 
 	for idx := range id {
 		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx],
 		)
 	}
 }
 
 // --------------------------------------------------------------------------------
-// - View 7
+// - View 6
 // --------------------------------------------------------------------------------
 
 // Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View7[A, B, C, D, E, F, G any] struct {
+type View6[A, B, C, D, E, F any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -2422,17 +4839,34 @@ type View7[A, B, C, D, E, F, G any] struct {
 	storageD *componentStorage[D]
 	storageE *componentStorage[E]
 	storageF *componentStorage[F]
-	storageG *componentStorage[G]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View7[A, B, C, D, E, F, G]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query7[A, B, C, D, E, F, G](world)
+// Note: Use View6F if the injected view needs filters
+func (v *View6[A, B, C, D, E, F]) Initialize(world *World) any {
+	return Query6[A, B, C, D, E, F](world)
+}
+
+// A View6 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View6F[A, B, C, D, E, F any, QF QueryFilter] struct {
+	View6[A, B, C, D, E, F]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View6F[A, B, C, D, E, F, QF]) Initialize(world *World) any {
+	return Query6F[A, B, C, D, E, F, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query6F[A, B, C, D, E, F any, QF QueryFilter](world *World) *View6F[A, B, C, D, E, F, QF] {
+	var f QF
+	return &View6F[A, B, C, D, E, F, QF]{
+		View6: *Query6[A, B, C, D, E, F](world, f.Filters()...),
+	}
 }
 
 // Creates a View for the specified world with the specified component filters.
-func Query7[A, B, C, D, E, F, G any](world *World, filters ...Filter) *View7[A, B, C, D, E, F, G] {
+func Query6[A, B, C, D, E, F any](world *World, filters ...Filter) *View6[A, B, C, D, E, F] {
 
 	storageA := getStorage[A](world.engine)
 	storageB := getStorage[B](world.engine)
@@ -2440,32 +4874,23 @@ func Query7[A, B, C, D, E, F, G any](world *World, filters ...Filter) *View7[A,
 	storageD := getStorage[D](world.engine)
 	storageE := getStorage[E](world.engine)
 	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
 
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
 
-	v := &View7[A, B, C, D, E, F, G]{
+	v := &View6[A, B, C, D, E, F]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -2473,72 +4898,103 @@ func Query7[A, B, C, D, E, F, G any](world *World, filters ...Filter) *View7[A,
 		storageD: storageD,
 		storageE: storageE,
 		storageF: storageF,
-		storageG: storageG,
 	}
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View6[A, B, C, D, E, F]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View7[A, B, C, D, E, F, G]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G) {
+func (v *View6[A, B, C, D, E, F]) Read(id Id) (*A, *B, *C, *D, *E, *F) {
 	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil
 	}
 
 	loc, ok := v.world.arch.Get(id)
 	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil
 	}
 	lookup := v.world.engine.lookup[loc.archId]
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
-	var retF *F
-	var retG *G
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
+	return retA, retB, retC, retD, retE, retF
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View6[A, B, C, D, E, F]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View6[A, B, C, D, E, F]) Get(id Id) (*A, *B, *C, *D, *E, *F, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, false
 	}
-	sliceG, ok := v.storageG.slice.Get(loc.archId)
-	if ok {
-		retG = &sliceG.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View6[A, B, C, D, E, F]) First() (Id, *A, *B, *C, *D, *E, *F, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, false
 	}
 
-	return retA, retB, retC, retD, retE, retF, retG
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View6[A, B, C, D, E, F]) Single() (Id, *A, *B, *C, *D, *E, *F, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
-func (v *View7[A, B, C, D, E, F, G]) Count() int {
+func (v *View6[A, B, C, D, E, F]) Count() int {
 	v.filter.regenerate(v.world)
 
 	total := 0
@@ -2548,42 +5004,46 @@ func (v *View7[A, B, C, D, E, F, G]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
-func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
+func (v *View6[A, B, C, D, E, F]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
 	var compF []F
 	var retF *F
-
-	var sliceG *componentList[G]
-	var compG []G
-	var retG *G
+	sparseF := v.storageF.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -2593,7 +5053,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 		sliceD, _ = v.storageD.slice.Get(archId)
 		sliceE, _ = v.storageE.slice.Get(archId)
 		sliceF, _ = v.storageF.slice.Get(archId)
-		sliceG, _ = v.storageG.slice.Get(archId)
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -2629,10 +5088,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 		if sliceF != nil {
 			compF = sliceF.comp
 		}
-		compG = nil
-		if sliceG != nil {
-			compG = sliceG.comp
-		}
 
 		retA = nil
 		retB = nil
@@ -2640,7 +5095,50 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 		retD = nil
 		retE = nil
 		retF = nil
-		retG = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -2664,56 +5162,666 @@ func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C,
 			if compF != nil {
 				retF = &compF[idx]
 			}
-			if compG != nil {
-				retG = &compG[idx]
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View6.All
+type Row6[A, B, C, D, E, F any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View6[A, B, C, D, E, F]) All() iter.Seq2[Id, Row6[A, B, C, D, E, F]] {
+	return func(yield func(Id, Row6[A, B, C, D, E, F]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var row Row6[A, B, C, D, E, F]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+
+			row = Row6[A, B, C, D, E, F]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
 		}
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
-	v.filter.regenerate(v.world)
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View6[A, B, C, D, E, F]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
 
 	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
 
-	var sliceG *componentList[G]
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+		}
 
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
 		}
+		if sparse {
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF)
 	}
+}
 
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
-	}
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View6[A, B, C, D, E, F]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
 
-	// 2. Calculate number of threads to execute with
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View6[A, B, C, D, E, F]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View6[A, B, C, D, E, F]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
+	}
+
+	// 2. Calculate number of threads to execute with
 	numThreads := runtime.NumCPU()
 
 	// Ensure that the number of threads we plan to use is <= total amount of work
@@ -2735,8 +5843,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 		compE []E
 
 		compF []F
-
-		compG []G
 	}
 	workChannel := make(chan workItem)
 
@@ -2757,7 +5863,50 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 				var retD *D
 				var retE *E
 				var retF *F
-				var retG *G
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -2781,10 +5930,7 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 					if work.compF != nil {
 						retF = &work.compF[idx]
 					}
-					if work.compG != nil {
-						retG = &work.compG[idx]
-					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG)
+					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF)
 				}
 			}
 		}()
@@ -2810,8 +5956,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 
 	var compF []F
 
-	var compG []G
-
 	for _, archId := range v.filter.archIds {
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -2825,7 +5969,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 		sliceD, _ = v.storageD.slice.Get(archId)
 		sliceE, _ = v.storageE.slice.Get(archId)
 		sliceF, _ = v.storageF.slice.Get(archId)
-		sliceG, _ = v.storageG.slice.Get(archId)
 
 		compA = nil
 		if sliceA != nil {
@@ -2851,10 +5994,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 		if sliceF != nil {
 			compF = sliceF.comp
 		}
-		compG = nil
-		if sliceG != nil {
-			compG = sliceG.comp
-		}
 
 		// workPerformed := 0
 
@@ -2870,23 +6009,29 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
-
-				compF: compF[start:end],
-
-				compG: compG[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			if compF != nil {
+				item.compF = compF[start:end]
 			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -2898,29 +6043,103 @@ func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B
 	waitGroup.Wait()
 }
 
-// Deprecated: This API is a tentative alternative way to map
-func (v *View7[A, B, C, D, E, F, G]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G)) {
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View6[A, B, C, D, E, F]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
-	id := make([][]Id, 0)
-
-	sliceListA := make([][]A, 0)
-	sliceListB := make([][]B, 0)
-	sliceListC := make([][]C, 0)
-	sliceListD := make([][]D, 0)
-	sliceListE := make([][]E, 0)
-	sliceListF := make([][]F, 0)
-	sliceListG := make([][]G, 0)
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
 
 	for _, archId := range v.filter.archIds {
-
-		sliceA, ok := v.storageA.slice.Get(archId)
-		if !ok {
-			continue
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
 		}
-		sliceB, ok := v.storageB.slice.Get(archId)
-		if !ok {
-			continue
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
+// Deprecated: This API is a tentative alternative way to map
+func (v *View6[A, B, C, D, E, F]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F)) {
+	v.filter.regenerate(v.world)
+
+	id := make([][]Id, 0)
+
+	sliceListA := make([][]A, 0)
+	sliceListB := make([][]B, 0)
+	sliceListC := make([][]C, 0)
+	sliceListD := make([][]D, 0)
+	sliceListE := make([][]E, 0)
+	sliceListF := make([][]F, 0)
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, ok := v.storageA.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceB, ok := v.storageB.slice.Get(archId)
+		if !ok {
+			continue
 		}
 		sliceC, ok := v.storageC.slice.Get(archId)
 		if !ok {
@@ -2938,10 +6157,6 @@ func (v *View7[A, B, C, D, E, F, G]) MapSlices(lambda func(id []Id, a []A, b []B
 		if !ok {
 			continue
 		}
-		sliceG, ok := v.storageG.slice.Get(archId)
-		if !ok {
-			continue
-		}
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -2958,24 +6173,24 @@ func (v *View7[A, B, C, D, E, F, G]) MapSlices(lambda func(id []Id, a []A, b []B
 		sliceListD = append(sliceListD, sliceD.comp)
 		sliceListE = append(sliceListE, sliceE.comp)
 		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
 	}
 
 	for idx := range id {
 		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx],
 		)
 	}
 }
 
 // --------------------------------------------------------------------------------
-// - View 8
+// - View 7
 // --------------------------------------------------------------------------------
 
 // Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View8[A, B, C, D, E, F, G, H any] struct {
+type View7[A, B, C, D, E, F, G any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -2984,17 +6199,34 @@ type View8[A, B, C, D, E, F, G, H any] struct {
 	storageE *componentStorage[E]
 	storageF *componentStorage[F]
 	storageG *componentStorage[G]
-	storageH *componentStorage[H]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View8[A, B, C, D, E, F, G, H]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query8[A, B, C, D, E, F, G, H](world)
+// Note: Use View7F if the injected view needs filters
+func (v *View7[A, B, C, D, E, F, G]) Initialize(world *World) any {
+	return Query7[A, B, C, D, E, F, G](world)
+}
+
+// A View7 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View7F[A, B, C, D, E, F, G any, QF QueryFilter] struct {
+	View7[A, B, C, D, E, F, G]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View7F[A, B, C, D, E, F, G, QF]) Initialize(world *World) any {
+	return Query7F[A, B, C, D, E, F, G, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query7F[A, B, C, D, E, F, G any, QF QueryFilter](world *World) *View7F[A, B, C, D, E, F, G, QF] {
+	var f QF
+	return &View7F[A, B, C, D, E, F, G, QF]{
+		View7: *Query7[A, B, C, D, E, F, G](world, f.Filters()...),
+	}
 }
 
 // Creates a View for the specified world with the specified component filters.
-func Query8[A, B, C, D, E, F, G, H any](world *World, filters ...Filter) *View8[A, B, C, D, E, F, G, H] {
+func Query7[A, B, C, D, E, F, G any](world *World, filters ...Filter) *View7[A, B, C, D, E, F, G] {
 
 	storageA := getStorage[A](world.engine)
 	storageB := getStorage[B](world.engine)
@@ -3003,34 +6235,24 @@ func Query8[A, B, C, D, E, F, G, H any](world *World, filters ...Filter) *View8[
 	storageE := getStorage[E](world.engine)
 	storageF := getStorage[F](world.engine)
 	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
 
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
+		compIdOf[G](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
 
-	v := &View8[A, B, C, D, E, F, G, H]{
+	v := &View7[A, B, C, D, E, F, G]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil || storageG.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -3039,77 +6261,106 @@ func Query8[A, B, C, D, E, F, G, H any](world *World, filters ...Filter) *View8[
 		storageE: storageE,
 		storageF: storageF,
 		storageG: storageG,
-		storageH: storageH,
 	}
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View7[A, B, C, D, E, F, G]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View8[A, B, C, D, E, F, G, H]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H) {
+func (v *View7[A, B, C, D, E, F, G]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G) {
 	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil
 	}
 
 	loc, ok := v.world.arch.Get(id)
 	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil
 	}
 	lookup := v.world.engine.lookup[loc.archId]
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
-	var retF *F
-	var retG *G
-	var retH *H
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
-	}
-	sliceG, ok := v.storageG.slice.Get(loc.archId)
-	if ok {
-		retG = &sliceG.comp[index]
+	return retA, retB, retC, retD, retE, retF, retG
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View7[A, B, C, D, E, F, G]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View7[A, B, C, D, E, F, G]) Get(id Id) (*A, *B, *C, *D, *E, *F, *G, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, false
 	}
-	sliceH, ok := v.storageH.slice.Get(loc.archId)
-	if ok {
-		retH = &sliceH.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View7[A, B, C, D, E, F, G]) First() (Id, *A, *B, *C, *D, *E, *F, *G, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, false
 	}
 
-	return retA, retB, retC, retD, retE, retF, retG, retH
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, retG, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View7[A, B, C, D, E, F, G]) Single() (Id, *A, *B, *C, *D, *E, *F, *G, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, retG, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, retG, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
-func (v *View8[A, B, C, D, E, F, G, H]) Count() int {
+func (v *View7[A, B, C, D, E, F, G]) Count() int {
 	v.filter.regenerate(v.world)
 
 	total := 0
@@ -3119,46 +6370,51 @@ func (v *View8[A, B, C, D, E, F, G, H]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
-func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
+func (v *View7[A, B, C, D, E, F, G]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
 	var compF []F
 	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
 	var compG []G
 	var retG *G
-
-	var sliceH *componentList[H]
-	var compH []H
-	var retH *H
+	sparseG := v.storageG.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -3169,7 +6425,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 		sliceE, _ = v.storageE.slice.Get(archId)
 		sliceF, _ = v.storageF.slice.Get(archId)
 		sliceG, _ = v.storageG.slice.Get(archId)
-		sliceH, _ = v.storageH.slice.Get(archId)
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -3209,10 +6464,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 		if sliceG != nil {
 			compG = sliceG.comp
 		}
-		compH = nil
-		if sliceH != nil {
-			compH = sliceH.comp
-		}
 
 		retA = nil
 		retB = nil
@@ -3221,7 +6472,55 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 		retE = nil
 		retF = nil
 		retG = nil
-		retH = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -3248,62 +6547,744 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *
 			if compG != nil {
 				retG = &compG[idx]
 			}
-			if compH != nil {
-				retH = &compH[idx]
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View7.All
+type Row7[A, B, C, D, E, F, G any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View7[A, B, C, D, E, F, G]) All() iter.Seq2[Id, Row7[A, B, C, D, E, F, G]] {
+	return func(yield func(Id, Row7[A, B, C, D, E, F, G]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var sliceG *componentList[G]
+		var compG []G
+		sparseG := v.storageG.sparse
+
+		var row Row7[A, B, C, D, E, F, G]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+			sliceG, _ = v.storageG.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+
+			row = Row7[A, B, C, D, E, F, G]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if compG != nil {
+						row.G = &compG[idx]
+					} else if sparseG != nil {
+						row.G = sparseG.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if compG != nil {
+					row.G = &compG[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
 		}
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
-	v.filter.regenerate(v.world)
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View7[A, B, C, D, E, F, G]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
 
 	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
 
-	var sliceH *componentList[H]
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+		}
 
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+		if compA != nil {
+			retA = &compA[idx]
 		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if sparse {
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
-
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG)
 	}
+}
 
-	// 2. Calculate number of threads to execute with
-	numThreads := runtime.NumCPU()
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View7[A, B, C, D, E, F, G]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
 
-	// Ensure that the number of threads we plan to use is <= total amount of work
-	numThreads = min(totalWork, numThreads)
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View7[A, B, C, D, E, F, G]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View7[A, B, C, D, E, F, G]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	sparseG := v.storageG.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
+	}
+
+	// 2. Calculate number of threads to execute with
+	numThreads := runtime.NumCPU()
+
+	// Ensure that the number of threads we plan to use is <= total amount of work
+	numThreads = min(totalWork, numThreads)
 
 	var waitGroup sync.WaitGroup
 
@@ -3323,8 +7304,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 		compF []F
 
 		compG []G
-
-		compH []H
 	}
 	workChannel := make(chan workItem)
 
@@ -3346,7 +7325,55 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 				var retE *E
 				var retF *F
 				var retG *G
-				var retH *H
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						if work.compG != nil {
+							retG = &work.compG[idx]
+						} else if sparseG != nil {
+							retG = sparseG.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -3373,10 +7400,7 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 					if work.compG != nil {
 						retG = &work.compG[idx]
 					}
-					if work.compH != nil {
-						retH = &work.compH[idx]
-					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG)
 				}
 			}
 		}()
@@ -3404,8 +7428,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 
 	var compG []G
 
-	var compH []H
-
 	for _, archId := range v.filter.archIds {
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -3420,7 +7442,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 		sliceE, _ = v.storageE.slice.Get(archId)
 		sliceF, _ = v.storageF.slice.Get(archId)
 		sliceG, _ = v.storageG.slice.Get(archId)
-		sliceH, _ = v.storageH.slice.Get(archId)
 
 		compA = nil
 		if sliceA != nil {
@@ -3450,10 +7471,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 		if sliceG != nil {
 			compG = sliceG.comp
 		}
-		compH = nil
-		if sliceH != nil {
-			compH = sliceH.comp
-		}
 
 		// workPerformed := 0
 
@@ -3469,25 +7486,32 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
-
-				compF: compF[start:end],
-
-				compG: compG[start:end],
-
-				compH: compH[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			if compF != nil {
+				item.compF = compF[start:end]
+			}
+			if compG != nil {
+				item.compG = compG[start:end]
 			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -3499,20 +7523,99 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b
 	waitGroup.Wait()
 }
 
-// Deprecated: This API is a tentative alternative way to map
-func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H)) {
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View7[A, B, C, D, E, F, G]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
-	id := make([][]Id, 0)
-
-	sliceListA := make([][]A, 0)
-	sliceListB := make([][]B, 0)
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
+	var compG []G
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG, ok := v.storageG.slice.Get(archId); ok {
+			compG = sliceG.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end), chunkOf(compG, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
+// Deprecated: This API is a tentative alternative way to map
+func (v *View7[A, B, C, D, E, F, G]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G)) {
+	v.filter.regenerate(v.world)
+
+	id := make([][]Id, 0)
+
+	sliceListA := make([][]A, 0)
+	sliceListB := make([][]B, 0)
 	sliceListC := make([][]C, 0)
 	sliceListD := make([][]D, 0)
 	sliceListE := make([][]E, 0)
 	sliceListF := make([][]F, 0)
 	sliceListG := make([][]G, 0)
-	sliceListH := make([][]H, 0)
 
 	for _, archId := range v.filter.archIds {
 
@@ -3544,10 +7647,6 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b
 		if !ok {
 			continue
 		}
-		sliceH, ok := v.storageH.slice.Get(archId)
-		if !ok {
-			continue
-		}
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -3565,24 +7664,24 @@ func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b
 		sliceListE = append(sliceListE, sliceE.comp)
 		sliceListF = append(sliceListF, sliceF.comp)
 		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
 	}
 
 	for idx := range id {
 		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx],
 		)
 	}
 }
 
 // --------------------------------------------------------------------------------
-// - View 9
+// - View 8
 // --------------------------------------------------------------------------------
 
 // Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View9[A, B, C, D, E, F, G, H, I any] struct {
+type View8[A, B, C, D, E, F, G, H any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -3592,17 +7691,34 @@ type View9[A, B, C, D, E, F, G, H, I any] struct {
 	storageF *componentStorage[F]
 	storageG *componentStorage[G]
 	storageH *componentStorage[H]
-	storageI *componentStorage[I]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View9[A, B, C, D, E, F, G, H, I]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query9[A, B, C, D, E, F, G, H, I](world)
+// Note: Use View8F if the injected view needs filters
+func (v *View8[A, B, C, D, E, F, G, H]) Initialize(world *World) any {
+	return Query8[A, B, C, D, E, F, G, H](world)
+}
+
+// A View8 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View8F[A, B, C, D, E, F, G, H any, QF QueryFilter] struct {
+	View8[A, B, C, D, E, F, G, H]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View8F[A, B, C, D, E, F, G, H, QF]) Initialize(world *World) any {
+	return Query8F[A, B, C, D, E, F, G, H, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query8F[A, B, C, D, E, F, G, H any, QF QueryFilter](world *World) *View8F[A, B, C, D, E, F, G, H, QF] {
+	var f QF
+	return &View8F[A, B, C, D, E, F, G, H, QF]{
+		View8: *Query8[A, B, C, D, E, F, G, H](world, f.Filters()...),
+	}
 }
 
 // Creates a View for the specified world with the specified component filters.
-func Query9[A, B, C, D, E, F, G, H, I any](world *World, filters ...Filter) *View9[A, B, C, D, E, F, G, H, I] {
+func Query8[A, B, C, D, E, F, G, H any](world *World, filters ...Filter) *View8[A, B, C, D, E, F, G, H] {
 
 	storageA := getStorage[A](world.engine)
 	storageB := getStorage[B](world.engine)
@@ -3612,36 +7728,25 @@ func Query9[A, B, C, D, E, F, G, H, I any](world *World, filters ...Filter) *Vie
 	storageF := getStorage[F](world.engine)
 	storageG := getStorage[G](world.engine)
 	storageH := getStorage[H](world.engine)
-	storageI := getStorage[I](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
 
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
+		compIdOf[G](),
+		compIdOf[H](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
 
-	v := &View9[A, B, C, D, E, F, G, H, I]{
+	v := &View8[A, B, C, D, E, F, G, H]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil || storageG.sparse != nil || storageH.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -3651,82 +7756,109 @@ func Query9[A, B, C, D, E, F, G, H, I any](world *World, filters ...Filter) *Vie
 		storageF: storageF,
 		storageG: storageG,
 		storageH: storageH,
-		storageI: storageI,
 	}
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View8[A, B, C, D, E, F, G, H]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View9[A, B, C, D, E, F, G, H, I]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I) {
+func (v *View8[A, B, C, D, E, F, G, H]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H) {
 	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
 	loc, ok := v.world.arch.Get(id)
 	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil, nil
 	}
 	lookup := v.world.engine.lookup[loc.archId]
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
-	var retF *F
-	var retG *G
-	var retH *H
-	var retI *I
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
-	}
-	sliceG, ok := v.storageG.slice.Get(loc.archId)
-	if ok {
-		retG = &sliceG.comp[index]
-	}
-	sliceH, ok := v.storageH.slice.Get(loc.archId)
-	if ok {
-		retH = &sliceH.comp[index]
+	return retA, retB, retC, retD, retE, retF, retG, retH
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View8[A, B, C, D, E, F, G, H]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View8[A, B, C, D, E, F, G, H]) Get(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, false
 	}
-	sliceI, ok := v.storageI.slice.Get(loc.archId)
-	if ok {
-		retI = &sliceI.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View8[A, B, C, D, E, F, G, H]) First() (Id, *A, *B, *C, *D, *E, *F, *G, *H, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, false
 	}
 
-	return retA, retB, retC, retD, retE, retF, retG, retH, retI
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, retG, retH, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View8[A, B, C, D, E, F, G, H]) Single() (Id, *A, *B, *C, *D, *E, *F, *G, *H, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, retG, retH, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, retG, retH, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
-func (v *View9[A, B, C, D, E, F, G, H, I]) Count() int {
+func (v *View8[A, B, C, D, E, F, G, H]) Count() int {
 	v.filter.regenerate(v.world)
 
 	total := 0
@@ -3736,50 +7868,56 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
-func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
+func (v *View8[A, B, C, D, E, F, G, H]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
 	var compF []F
 	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
 	var compG []G
 	var retG *G
+	sparseG := v.storageG.sparse
 
 	var sliceH *componentList[H]
 	var compH []H
 	var retH *H
-
-	var sliceI *componentList[I]
-	var compI []I
-	var retI *I
+	sparseH := v.storageH.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -3791,7 +7929,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 		sliceF, _ = v.storageF.slice.Get(archId)
 		sliceG, _ = v.storageG.slice.Get(archId)
 		sliceH, _ = v.storageH.slice.Get(archId)
-		sliceI, _ = v.storageI.slice.Get(archId)
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -3835,10 +7972,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 		if sliceH != nil {
 			compH = sliceH.comp
 		}
-		compI = nil
-		if sliceI != nil {
-			compI = sliceI.comp
-		}
 
 		retA = nil
 		retB = nil
@@ -3848,7 +7981,60 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 		retF = nil
 		retG = nil
 		retH = nil
-		retI = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -3878,56 +8064,810 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B,
 			if compH != nil {
 				retH = &compH[idx]
 			}
-			if compI != nil {
-				retI = &compI[idx]
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View8.All
+type Row8[A, B, C, D, E, F, G, H any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View8[A, B, C, D, E, F, G, H]) All() iter.Seq2[Id, Row8[A, B, C, D, E, F, G, H]] {
+	return func(yield func(Id, Row8[A, B, C, D, E, F, G, H]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var sliceG *componentList[G]
+		var compG []G
+		sparseG := v.storageG.sparse
+
+		var sliceH *componentList[H]
+		var compH []H
+		sparseH := v.storageH.sparse
+
+		var row Row8[A, B, C, D, E, F, G, H]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+			sliceG, _ = v.storageG.slice.Get(archId)
+			sliceH, _ = v.storageH.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+
+			row = Row8[A, B, C, D, E, F, G, H]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if compG != nil {
+						row.G = &compG[idx]
+					} else if sparseG != nil {
+						row.G = sparseG.get(ids[idx])
+					}
+					if compH != nil {
+						row.H = &compH[idx]
+					} else if sparseH != nil {
+						row.H = sparseH.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if compG != nil {
+					row.G = &compG[idx]
+				}
+				if compH != nil {
+					row.H = &compH[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
 		}
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
-	v.filter.regenerate(v.world)
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View8[A, B, C, D, E, F, G, H]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
 
 	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
 
 	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
 
-	var sliceI *componentList[I]
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+		}
 
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+		if compA != nil {
+			retA = &compA[idx]
 		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if sparse {
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
-
-	// Nothing to do if there is no work
-	if totalWork == 0 {
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View8[A, B, C, D, E, F, G, H]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View8[A, B, C, D, E, F, G, H]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View8[A, B, C, D, E, F, G, H]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	sparseH := v.storageH.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
 		return
 	}
 
@@ -3957,8 +8897,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 		compG []G
 
 		compH []H
-
-		compI []I
 	}
 	workChannel := make(chan workItem)
 
@@ -3981,7 +8919,60 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 				var retF *F
 				var retG *G
 				var retH *H
-				var retI *I
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						if work.compG != nil {
+							retG = &work.compG[idx]
+						} else if sparseG != nil {
+							retG = sparseG.get(work.ids[idx])
+						}
+						if work.compH != nil {
+							retH = &work.compH[idx]
+						} else if sparseH != nil {
+							retH = sparseH.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -4011,10 +9002,7 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 					if work.compH != nil {
 						retH = &work.compH[idx]
 					}
-					if work.compI != nil {
-						retI = &work.compI[idx]
-					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH)
 				}
 			}
 		}()
@@ -4044,8 +9032,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 
 	var compH []H
 
-	var compI []I
-
 	for _, archId := range v.filter.archIds {
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -4061,7 +9047,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 		sliceF, _ = v.storageF.slice.Get(archId)
 		sliceG, _ = v.storageG.slice.Get(archId)
 		sliceH, _ = v.storageH.slice.Get(archId)
-		sliceI, _ = v.storageI.slice.Get(archId)
 
 		compA = nil
 		if sliceA != nil {
@@ -4095,10 +9080,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 		if sliceH != nil {
 			compH = sliceH.comp
 		}
-		compI = nil
-		if sliceI != nil {
-			compI = sliceI.comp
-		}
 
 		// workPerformed := 0
 
@@ -4114,27 +9095,35 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
-
-				compF: compF[start:end],
-
-				compG: compG[start:end],
-
-				compH: compH[start:end],
-
-				compI: compI[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			if compF != nil {
+				item.compF = compF[start:end]
+			}
+			if compG != nil {
+				item.compG = compG[start:end]
+			}
+			if compH != nil {
+				item.compH = compH[start:end]
 			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -4146,8 +9135,93 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A
 	waitGroup.Wait()
 }
 
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View8[A, B, C, D, E, F, G, H]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
+	var compG []G
+	var compH []H
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG, ok := v.storageG.slice.Get(archId); ok {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH, ok := v.storageH.slice.Get(archId); ok {
+			compH = sliceH.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end), chunkOf(compG, start, end), chunkOf(compH, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
-func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I)) {
+func (v *View8[A, B, C, D, E, F, G, H]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H)) {
 	v.filter.regenerate(v.world)
 
 	id := make([][]Id, 0)
@@ -4160,7 +9234,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A,
 	sliceListF := make([][]F, 0)
 	sliceListG := make([][]G, 0)
 	sliceListH := make([][]H, 0)
-	sliceListI := make([][]I, 0)
 
 	for _, archId := range v.filter.archIds {
 
@@ -4196,10 +9269,6 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A,
 		if !ok {
 			continue
 		}
-		sliceI, ok := v.storageI.slice.Get(archId)
-		if !ok {
-			continue
-		}
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -4218,24 +9287,24 @@ func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A,
 		sliceListF = append(sliceListF, sliceF.comp)
 		sliceListG = append(sliceListG, sliceG.comp)
 		sliceListH = append(sliceListH, sliceH.comp)
-		sliceListI = append(sliceListI, sliceI.comp)
 	}
 
 	for idx := range id {
 		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx],
 		)
 	}
 }
 
 // --------------------------------------------------------------------------------
-// - View 10
+// - View 9
 // --------------------------------------------------------------------------------
 
 // Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View10[A, B, C, D, E, F, G, H, I, J any] struct {
+type View9[A, B, C, D, E, F, G, H, I any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -4246,17 +9315,34 @@ type View10[A, B, C, D, E, F, G, H, I, J any] struct {
 	storageG *componentStorage[G]
 	storageH *componentStorage[H]
 	storageI *componentStorage[I]
-	storageJ *componentStorage[J]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View10[A, B, C, D, E, F, G, H, I, J]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query10[A, B, C, D, E, F, G, H, I, J](world)
+// Note: Use View9F if the injected view needs filters
+func (v *View9[A, B, C, D, E, F, G, H, I]) Initialize(world *World) any {
+	return Query9[A, B, C, D, E, F, G, H, I](world)
+}
+
+// A View9 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View9F[A, B, C, D, E, F, G, H, I any, QF QueryFilter] struct {
+	View9[A, B, C, D, E, F, G, H, I]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View9F[A, B, C, D, E, F, G, H, I, QF]) Initialize(world *World) any {
+	return Query9F[A, B, C, D, E, F, G, H, I, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query9F[A, B, C, D, E, F, G, H, I any, QF QueryFilter](world *World) *View9F[A, B, C, D, E, F, G, H, I, QF] {
+	var f QF
+	return &View9F[A, B, C, D, E, F, G, H, I, QF]{
+		View9: *Query9[A, B, C, D, E, F, G, H, I](world, f.Filters()...),
+	}
 }
 
 // Creates a View for the specified world with the specified component filters.
-func Query10[A, B, C, D, E, F, G, H, I, J any](world *World, filters ...Filter) *View10[A, B, C, D, E, F, G, H, I, J] {
+func Query9[A, B, C, D, E, F, G, H, I any](world *World, filters ...Filter) *View9[A, B, C, D, E, F, G, H, I] {
 
 	storageA := getStorage[A](world.engine)
 	storageB := getStorage[B](world.engine)
@@ -4267,38 +9353,26 @@ func Query10[A, B, C, D, E, F, G, H, I, J any](world *World, filters ...Filter)
 	storageG := getStorage[G](world.engine)
 	storageH := getStorage[H](world.engine)
 	storageI := getStorage[I](world.engine)
-	storageJ := getStorage[J](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-	var JJ J
 
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-		name(JJ),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
+		compIdOf[G](),
+		compIdOf[H](),
+		compIdOf[I](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
 
-	v := &View10[A, B, C, D, E, F, G, H, I, J]{
+	v := &View9[A, B, C, D, E, F, G, H, I]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil || storageG.sparse != nil || storageH.sparse != nil || storageI.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -4309,87 +9383,112 @@ func Query10[A, B, C, D, E, F, G, H, I, J any](world *World, filters ...Filter)
 		storageG: storageG,
 		storageH: storageH,
 		storageI: storageI,
-		storageJ: storageJ,
 	}
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View9[A, B, C, D, E, F, G, H, I]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View10[A, B, C, D, E, F, G, H, I, J]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J) {
+func (v *View9[A, B, C, D, E, F, G, H, I]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I) {
 	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
 	loc, ok := v.world.arch.Get(id)
 	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 	lookup := v.world.engine.lookup[loc.archId]
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
-	var retF *F
-	var retG *G
-	var retH *H
-	var retI *I
-	var retJ *J
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
-	}
-	sliceG, ok := v.storageG.slice.Get(loc.archId)
-	if ok {
-		retG = &sliceG.comp[index]
-	}
-	sliceH, ok := v.storageH.slice.Get(loc.archId)
-	if ok {
-		retH = &sliceH.comp[index]
-	}
-	sliceI, ok := v.storageI.slice.Get(loc.archId)
-	if ok {
-		retI = &sliceI.comp[index]
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View9[A, B, C, D, E, F, G, H, I]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View9[A, B, C, D, E, F, G, H, I]) Get(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, false
 	}
-	sliceJ, ok := v.storageJ.slice.Get(loc.archId)
-	if ok {
-		retJ = &sliceJ.comp[index]
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View9[A, B, C, D, E, F, G, H, I]) First() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
 	}
 
-	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View9[A, B, C, D, E, F, G, H, I]) Single() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, retG, retH, retI, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
 }
 
 // Counts the number of entities that match this query
-func (v *View10[A, B, C, D, E, F, G, H, I, J]) Count() int {
+func (v *View9[A, B, C, D, E, F, G, H, I]) Count() int {
 	v.filter.regenerate(v.world)
 
 	total := 0
@@ -4399,54 +9498,61 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) Count() int {
 			panic("LookupList is missing!")
 		}
 
-		total += lookup.Len()
+		total += v.filter.countArchetype(v.world.engine, lookup)
 	}
 	return total
 }
 
 // Maps the lambda function across every entity which matched the specified filters.
-func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
 	var compF []F
 	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
 	var compG []G
 	var retG *G
+	sparseG := v.storageG.sparse
 
 	var sliceH *componentList[H]
 	var compH []H
 	var retH *H
+	sparseH := v.storageH.sparse
 
 	var sliceI *componentList[I]
 	var compI []I
 	var retI *I
-
-	var sliceJ *componentList[J]
-	var compJ []J
-	var retJ *J
+	sparseI := v.storageI.sparse
 
 	for _, archId := range v.filter.archIds {
 
@@ -4459,7 +9565,6 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 		sliceG, _ = v.storageG.slice.Get(archId)
 		sliceH, _ = v.storageH.slice.Get(archId)
 		sliceI, _ = v.storageI.slice.Get(archId)
-		sliceJ, _ = v.storageJ.slice.Get(archId)
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -4507,10 +9612,6 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 		if sliceI != nil {
 			compI = sliceI.comp
 		}
-		compJ = nil
-		if sliceJ != nil {
-			compJ = sliceJ.comp
-		}
 
 		retA = nil
 		retB = nil
@@ -4521,7 +9622,65 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 		retG = nil
 		retH = nil
 		retI = nil
-		retJ = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+			}
+			continue
+		}
+
 		for idx := range ids {
 			if ids[idx] == InvalidEntity {
 				continue
@@ -4554,190 +9713,657 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b
 			if compI != nil {
 				retI = &compI[idx]
 			}
-			if compJ != nil {
-				retJ = &compJ[idx]
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View9.All
+type Row9[A, B, C, D, E, F, G, H, I any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View9[A, B, C, D, E, F, G, H, I]) All() iter.Seq2[Id, Row9[A, B, C, D, E, F, G, H, I]] {
+	return func(yield func(Id, Row9[A, B, C, D, E, F, G, H, I]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var sliceG *componentList[G]
+		var compG []G
+		sparseG := v.storageG.sparse
+
+		var sliceH *componentList[H]
+		var compH []H
+		sparseH := v.storageH.sparse
+
+		var sliceI *componentList[I]
+		var compI []I
+		sparseI := v.storageI.sparse
+
+		var row Row9[A, B, C, D, E, F, G, H, I]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+			sliceG, _ = v.storageG.slice.Get(archId)
+			sliceH, _ = v.storageH.slice.Get(archId)
+			sliceI, _ = v.storageI.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+
+			row = Row9[A, B, C, D, E, F, G, H, I]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if compG != nil {
+						row.G = &compG[idx]
+					} else if sparseG != nil {
+						row.G = sparseG.get(ids[idx])
+					}
+					if compH != nil {
+						row.H = &compH[idx]
+					} else if sparseH != nil {
+						row.H = sparseH.get(ids[idx])
+					}
+					if compI != nil {
+						row.I = &compI[idx]
+					} else if sparseI != nil {
+						row.I = sparseI.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if compG != nil {
+					row.G = &compG[idx]
+				}
+				if compH != nil {
+					row.H = &compH[idx]
+				}
+				if compI != nil {
+					row.I = &compI[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
 		}
 	}
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
-	v.filter.regenerate(v.world)
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
 
 	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
 
 	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
 
 	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
 
-	var sliceJ *componentList[J]
-
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
-
-	// 1. Calculate work
-	totalWork := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
 		}
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
-
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
-	}
-
-	// 2. Calculate number of threads to execute with
-	numThreads := runtime.NumCPU()
-
-	// Ensure that the number of threads we plan to use is <= total amount of work
-	numThreads = min(totalWork, numThreads)
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-	var waitGroup sync.WaitGroup
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if sparse {
 
-	type workItem struct {
-		ids []Id
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI)
+	}
+}
 
-		compA []A
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
 
-		compB []B
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
-		compC []C
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
-		compD []D
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
-		compE []E
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
-		compF []F
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
 
-		compG []G
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
 
-		compH []H
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
 
-		compI []I
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
 
-		compJ []J
-	}
-	workChannel := make(chan workItem)
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
 
-	for i := 0; i < numThreads; i++ {
-		waitGroup.Add(1)
-		go func() {
-			defer waitGroup.Done()
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+		}
 
-			for {
-				work, ok := <-workChannel
-				if !ok {
-					return
-				}
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-				var retA *A
-				var retB *B
-				var retC *C
-				var retD *D
-				var retE *E
-				var retF *F
-				var retG *G
-				var retH *H
-				var retI *I
-				var retJ *J
-				for idx := range work.ids {
-					if work.ids[idx] == InvalidEntity {
-						continue
-					} // Skip if its a hole
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if sparse {
 
-					if work.compA != nil {
-						retA = &work.compA[idx]
-					}
-					if work.compB != nil {
-						retB = &work.compB[idx]
-					}
-					if work.compC != nil {
-						retC = &work.compC[idx]
-					}
-					if work.compD != nil {
-						retD = &work.compD[idx]
-					}
-					if work.compE != nil {
-						retE = &work.compE[idx]
-					}
-					if work.compF != nil {
-						retF = &work.compF[idx]
-					}
-					if work.compG != nil {
-						retG = &work.compG[idx]
-					}
-					if work.compH != nil {
-						retH = &work.compH[idx]
-					}
-					if work.compI != nil {
-						retI = &work.compI[idx]
-					}
-					if work.compJ != nil {
-						retJ = &work.compJ[idx]
-					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
-				}
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
 			}
-		}()
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI)
 	}
+}
 
-	// 3. Greedy divide work among N threads
-	// Simple algorithm:
-	// a. Find an evenly balanced distribution per thread
-	// b. Generate all work until it gets consumed
-	workPerThread := totalWork / numThreads
-
-	// Generate
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
 
+	var sliceA *componentList[A]
 	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
 
+	var sliceB *componentList[B]
 	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
 
+	var sliceC *componentList[C]
 	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
 
+	var sliceD *componentList[D]
 	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
 
+	var sliceE *componentList[E]
 	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
 
+	var sliceF *componentList[F]
 	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
 
+	var sliceG *componentList[G]
 	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
 
+	var sliceH *componentList[H]
 	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
 
+	var sliceI *componentList[I]
 	var compI []I
-
-	var compJ []J
+	var retI *I
+	sparseI := v.storageI.sparse
 
 	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
 		}
-		ids := lookup.id
 
 		sliceA, _ = v.storageA.slice.Get(archId)
 		sliceB, _ = v.storageB.slice.Get(archId)
@@ -4748,7 +10374,12 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdParallel(lambda func(id Id,
 		sliceG, _ = v.storageG.slice.Get(archId)
 		sliceH, _ = v.storageH.slice.Get(archId)
 		sliceI, _ = v.storageI.slice.Get(archId)
-		sliceJ, _ = v.storageJ.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
 
 		compA = nil
 		if sliceA != nil {
@@ -4786,382 +10417,363 @@ func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdParallel(lambda func(id Id,
 		if sliceI != nil {
 			compI = sliceI.comp
 		}
-		compJ = nil
-		if sliceJ != nil {
-			compJ = sliceJ.comp
-		}
 
-		// workPerformed := 0
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		retI = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
 
-		start := 0
-		end := 0
-		numWorkItems := (len(ids) / workPerThread) + 1
-		actualWorkPerThread := (len(ids) / numWorkItems) + 1
-		for i := 0; i < numWorkItems; i++ {
-			start = i * actualWorkPerThread
-			end = (i + 1) * actualWorkPerThread
-			if end > len(ids) {
-				end = len(ids)
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+				cursor.visited++
 			}
+		}
+		cursor.index = len(ids)
+	}
 
-			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
-				ids: ids[start:end],
-
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
+	cursor.Reset()
+	return true
+}
 
-				compE: compE[start:end],
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
-				compF: compF[start:end],
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
 
-				compG: compG[start:end],
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
 
-				compH: compH[start:end],
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
 
-				compI: compI[start:end],
+	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
 
-				compJ: compJ[start:end],
-			}
-		}
+	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
 
-		// if workPerformed != len(ids) {
-		// 	panic("wrong")
-		// }
-	}
+	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
 
-	close(workChannel)
-	waitGroup.Wait()
-}
+	var sliceG *componentList[G]
+	sparseG := v.storageG.sparse
 
-// Deprecated: This API is a tentative alternative way to map
-func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J)) {
-	v.filter.regenerate(v.world)
+	var sliceH *componentList[H]
+	sparseH := v.storageH.sparse
 
-	id := make([][]Id, 0)
+	var sliceI *componentList[I]
+	sparseI := v.storageI.sparse
 
-	sliceListA := make([][]A, 0)
-	sliceListB := make([][]B, 0)
-	sliceListC := make([][]C, 0)
-	sliceListD := make([][]D, 0)
-	sliceListE := make([][]E, 0)
-	sliceListF := make([][]F, 0)
-	sliceListG := make([][]G, 0)
-	sliceListH := make([][]H, 0)
-	sliceListI := make([][]I, 0)
-	sliceListJ := make([][]J, 0)
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
 
+	// 1. Calculate work
+	totalWork := 0
 	for _, archId := range v.filter.archIds {
-
-		sliceA, ok := v.storageA.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceB, ok := v.storageB.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceC, ok := v.storageC.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceD, ok := v.storageD.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceE, ok := v.storageE.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceF, ok := v.storageF.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceG, ok := v.storageG.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceH, ok := v.storageH.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceI, ok := v.storageI.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceJ, ok := v.storageJ.slice.Get(archId)
-		if !ok {
-			continue
-		}
-
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
 			panic("LookupList is missing!")
 		}
-		// lookup, ok := v.world.engine.lookup[archId]
-		// if !ok { panic("LookupList is missing!") }
-
-		id = append(id, lookup.id)
 
-		sliceListA = append(sliceListA, sliceA.comp)
-		sliceListB = append(sliceListB, sliceB.comp)
-		sliceListC = append(sliceListC, sliceC.comp)
-		sliceListD = append(sliceListD, sliceD.comp)
-		sliceListE = append(sliceListE, sliceE.comp)
-		sliceListF = append(sliceListF, sliceF.comp)
-		sliceListG = append(sliceListG, sliceG.comp)
-		sliceListH = append(sliceListH, sliceH.comp)
-		sliceListI = append(sliceListI, sliceI.comp)
-		sliceListJ = append(sliceListJ, sliceJ.comp)
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
 	}
 
-	for idx := range id {
-		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx],
-		)
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
 	}
-}
-
-// --------------------------------------------------------------------------------
-// - View 11
-// --------------------------------------------------------------------------------
-
-// Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View11[A, B, C, D, E, F, G, H, I, J, K any] struct {
-	world  *World
-	filter filterList
 
-	storageA *componentStorage[A]
-	storageB *componentStorage[B]
-	storageC *componentStorage[C]
-	storageD *componentStorage[D]
-	storageE *componentStorage[E]
-	storageF *componentStorage[F]
-	storageG *componentStorage[G]
-	storageH *componentStorage[H]
-	storageI *componentStorage[I]
-	storageJ *componentStorage[J]
-	storageK *componentStorage[K]
-}
+	// 2. Calculate number of threads to execute with
+	numThreads := runtime.NumCPU()
 
-// implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query11[A, B, C, D, E, F, G, H, I, J, K](world)
-}
+	// Ensure that the number of threads we plan to use is <= total amount of work
+	numThreads = min(totalWork, numThreads)
 
-// Creates a View for the specified world with the specified component filters.
-func Query11[A, B, C, D, E, F, G, H, I, J, K any](world *World, filters ...Filter) *View11[A, B, C, D, E, F, G, H, I, J, K] {
+	var waitGroup sync.WaitGroup
 
-	storageA := getStorage[A](world.engine)
-	storageB := getStorage[B](world.engine)
-	storageC := getStorage[C](world.engine)
-	storageD := getStorage[D](world.engine)
-	storageE := getStorage[E](world.engine)
-	storageF := getStorage[F](world.engine)
-	storageG := getStorage[G](world.engine)
-	storageH := getStorage[H](world.engine)
-	storageI := getStorage[I](world.engine)
-	storageJ := getStorage[J](world.engine)
-	storageK := getStorage[K](world.engine)
+	type workItem struct {
+		ids []Id
 
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-	var JJ J
-	var KK K
+		compA []A
 
-	comps := []CompId{
+		compB []B
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-		name(JJ),
-		name(KK),
-	}
-	filterList := newFilterList(comps, filters...)
-	filterList.regenerate(world)
+		compC []C
 
-	v := &View11[A, B, C, D, E, F, G, H, I, J, K]{
-		world:  world,
-		filter: filterList,
+		compD []D
 
-		storageA: storageA,
-		storageB: storageB,
-		storageC: storageC,
-		storageD: storageD,
-		storageE: storageE,
-		storageF: storageF,
-		storageG: storageG,
-		storageH: storageH,
-		storageI: storageI,
-		storageJ: storageJ,
-		storageK: storageK,
-	}
-	return v
-}
+		compE []E
 
-// Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
-// Read will return the value if it exists, else returns nil.
-// If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K) {
-	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
-	}
+		compF []F
 
-	loc, ok := v.world.arch.Get(id)
-	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
-	}
-	lookup := v.world.engine.lookup[loc.archId]
-	if lookup == nil {
-		panic("LookupList is missing!")
-	}
-	index := int(loc.index)
+		compG []G
 
-	var retA *A
-	var retB *B
-	var retC *C
-	var retD *D
-	var retE *E
-	var retF *F
-	var retG *G
-	var retH *H
-	var retI *I
-	var retJ *J
-	var retK *K
+		compH []H
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
-	}
-	sliceG, ok := v.storageG.slice.Get(loc.archId)
-	if ok {
-		retG = &sliceG.comp[index]
-	}
-	sliceH, ok := v.storageH.slice.Get(loc.archId)
-	if ok {
-		retH = &sliceH.comp[index]
-	}
-	sliceI, ok := v.storageI.slice.Get(loc.archId)
-	if ok {
-		retI = &sliceI.comp[index]
-	}
-	sliceJ, ok := v.storageJ.slice.Get(loc.archId)
-	if ok {
-		retJ = &sliceJ.comp[index]
-	}
-	sliceK, ok := v.storageK.slice.Get(loc.archId)
-	if ok {
-		retK = &sliceK.comp[index]
+		compI []I
 	}
+	workChannel := make(chan workItem)
 
-	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK
-}
+	for i := 0; i < numThreads; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
 
-// Counts the number of entities that match this query
-func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Count() int {
-	v.filter.regenerate(v.world)
+			for {
+				work, ok := <-workChannel
+				if !ok {
+					return
+				}
 
-	total := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
-		}
+				var retA *A
+				var retB *B
+				var retC *C
+				var retD *D
+				var retE *E
+				var retF *F
+				var retG *G
+				var retH *H
+				var retI *I
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						if work.compG != nil {
+							retG = &work.compG[idx]
+						} else if sparseG != nil {
+							retG = sparseG.get(work.ids[idx])
+						}
+						if work.compH != nil {
+							retH = &work.compH[idx]
+						} else if sparseH != nil {
+							retH = sparseH.get(work.ids[idx])
+						}
+						if work.compI != nil {
+							retI = &work.compI[idx]
+						} else if sparseI != nil {
+							retI = sparseI.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+					}
+					continue
+				}
+
+				for idx := range work.ids {
+					if work.ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
 
-		total += lookup.Len()
+					if work.compA != nil {
+						retA = &work.compA[idx]
+					}
+					if work.compB != nil {
+						retB = &work.compB[idx]
+					}
+					if work.compC != nil {
+						retC = &work.compC[idx]
+					}
+					if work.compD != nil {
+						retD = &work.compD[idx]
+					}
+					if work.compE != nil {
+						retE = &work.compE[idx]
+					}
+					if work.compF != nil {
+						retF = &work.compF[idx]
+					}
+					if work.compG != nil {
+						retG = &work.compG[idx]
+					}
+					if work.compH != nil {
+						retH = &work.compH[idx]
+					}
+					if work.compI != nil {
+						retI = &work.compI[idx]
+					}
+					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI)
+				}
+			}
+		}()
 	}
-	return total
-}
 
-// Maps the lambda function across every entity which matched the specified filters.
-func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
-	v.filter.regenerate(v.world)
+	// 3. Greedy divide work among N threads
+	// Simple algorithm:
+	// a. Find an evenly balanced distribution per thread
+	// b. Generate all work until it gets consumed
+	workPerThread := totalWork / numThreads
+
+	// Generate
 
-	var sliceA *componentList[A]
 	var compA []A
-	var retA *A
 
-	var sliceB *componentList[B]
 	var compB []B
-	var retB *B
 
-	var sliceC *componentList[C]
 	var compC []C
-	var retC *C
 
-	var sliceD *componentList[D]
 	var compD []D
-	var retD *D
 
-	var sliceE *componentList[E]
 	var compE []E
-	var retE *E
 
-	var sliceF *componentList[F]
 	var compF []F
-	var retF *F
 
-	var sliceG *componentList[G]
 	var compG []G
-	var retG *G
 
-	var sliceH *componentList[H]
 	var compH []H
-	var retH *H
 
-	var sliceI *componentList[I]
 	var compI []I
-	var retI *I
-
-	var sliceJ *componentList[J]
-	var compJ []J
-	var retJ *J
-
-	var sliceK *componentList[K]
-	var compK []K
-	var retK *K
 
 	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
 
 		sliceA, _ = v.storageA.slice.Get(archId)
 		sliceB, _ = v.storageB.slice.Get(archId)
@@ -5172,18 +10784,6 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A,
 		sliceG, _ = v.storageG.slice.Get(archId)
 		sliceH, _ = v.storageH.slice.Get(archId)
 		sliceI, _ = v.storageI.slice.Get(archId)
-		sliceJ, _ = v.storageJ.slice.Get(archId)
-		sliceK, _ = v.storageK.slice.Get(archId)
-
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
-		}
-		// lookup, ok := v.world.engine.lookup[archId]
-		// if !ok { panic("LookupList is missing!") }
-		ids := lookup.id
-
-		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
 
 		compA = nil
 		if sliceA != nil {
@@ -5221,365 +10821,156 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A,
 		if sliceI != nil {
 			compI = sliceI.comp
 		}
-		compJ = nil
-		if sliceJ != nil {
-			compJ = sliceJ.comp
-		}
-		compK = nil
-		if sliceK != nil {
-			compK = sliceK.comp
-		}
 
-		retA = nil
-		retB = nil
-		retC = nil
-		retD = nil
-		retE = nil
-		retF = nil
-		retG = nil
-		retH = nil
-		retI = nil
-		retJ = nil
-		retK = nil
-		for idx := range ids {
-			if ids[idx] == InvalidEntity {
-				continue
-			} // Skip if its a hole
+		// workPerformed := 0
+
+		start := 0
+		end := 0
+		numWorkItems := (len(ids) / workPerThread) + 1
+		actualWorkPerThread := (len(ids) / numWorkItems) + 1
+		for i := 0; i < numWorkItems; i++ {
+			start = i * actualWorkPerThread
+			end = (i + 1) * actualWorkPerThread
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			// workPerformed += len(ids[start:end])
+			item := workItem{
+				ids: ids[start:end],
+			}
 
 			if compA != nil {
-				retA = &compA[idx]
+				item.compA = compA[start:end]
 			}
 			if compB != nil {
-				retB = &compB[idx]
+				item.compB = compB[start:end]
 			}
 			if compC != nil {
-				retC = &compC[idx]
+				item.compC = compC[start:end]
 			}
 			if compD != nil {
-				retD = &compD[idx]
+				item.compD = compD[start:end]
 			}
 			if compE != nil {
-				retE = &compE[idx]
+				item.compE = compE[start:end]
 			}
 			if compF != nil {
-				retF = &compF[idx]
+				item.compF = compF[start:end]
 			}
 			if compG != nil {
-				retG = &compG[idx]
+				item.compG = compG[start:end]
 			}
 			if compH != nil {
-				retH = &compH[idx]
+				item.compH = compH[start:end]
 			}
 			if compI != nil {
-				retI = &compI[idx]
-			}
-			if compJ != nil {
-				retJ = &compJ[idx]
-			}
-			if compK != nil {
-				retK = &compK[idx]
+				item.compI = compI[start:end]
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+			workChannel <- item
 		}
+
+		// if workPerformed != len(ids) {
+		// 	panic("wrong")
+		// }
 	}
+
+	close(workChannel)
+	waitGroup.Wait()
 }
 
-// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
-func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
-	var sliceA *componentList[A]
-
-	var sliceB *componentList[B]
-
-	var sliceC *componentList[C]
-
-	var sliceD *componentList[D]
-
-	var sliceE *componentList[E]
-
-	var sliceF *componentList[F]
-
-	var sliceG *componentList[G]
-
-	var sliceH *componentList[H]
-
-	var sliceI *componentList[I]
-
-	var sliceJ *componentList[J]
-
-	var sliceK *componentList[K]
-
-	// 1. Calculate work
-	// 2. Calculate number of threads to execute with
-	// 3. Greedy divide work among N threads
-	// 4. Execute for each in its own goroutine
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
+	var compG []G
+	var compH []H
+	var compI []I
 
-	// 1. Calculate work
-	totalWork := 0
 	for _, archId := range v.filter.archIds {
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
 			panic("LookupList is missing!")
 		}
+		ids := lookup.id
 
-		// Each id represents an entity that holds the requested component(s)
-		// Each hole represents a deleted entity that used to hold the requested component(s)
-		totalWork += len(lookup.id) // - len(lookup.holes)
-	}
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG, ok := v.storageG.slice.Get(archId); ok {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH, ok := v.storageH.slice.Get(archId); ok {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI, ok := v.storageI.slice.Get(archId); ok {
+			compI = sliceI.comp
+		}
 
-	// Nothing to do if there is no work
-	if totalWork == 0 {
-		return
-	}
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
 
-	// 2. Calculate number of threads to execute with
-	numThreads := runtime.NumCPU()
-
-	// Ensure that the number of threads we plan to use is <= total amount of work
-	numThreads = min(totalWork, numThreads)
-
-	var waitGroup sync.WaitGroup
-
-	type workItem struct {
-		ids []Id
-
-		compA []A
-
-		compB []B
-
-		compC []C
-
-		compD []D
-
-		compE []E
-
-		compF []F
-
-		compG []G
-
-		compH []H
-
-		compI []I
-
-		compJ []J
-
-		compK []K
-	}
-	workChannel := make(chan workItem)
-
-	for i := 0; i < numThreads; i++ {
-		waitGroup.Add(1)
-		go func() {
-			defer waitGroup.Done()
-
-			for {
-				work, ok := <-workChannel
-				if !ok {
-					return
-				}
-
-				var retA *A
-				var retB *B
-				var retC *C
-				var retD *D
-				var retE *E
-				var retF *F
-				var retG *G
-				var retH *H
-				var retI *I
-				var retJ *J
-				var retK *K
-				for idx := range work.ids {
-					if work.ids[idx] == InvalidEntity {
-						continue
-					} // Skip if its a hole
-
-					if work.compA != nil {
-						retA = &work.compA[idx]
-					}
-					if work.compB != nil {
-						retB = &work.compB[idx]
-					}
-					if work.compC != nil {
-						retC = &work.compC[idx]
-					}
-					if work.compD != nil {
-						retD = &work.compD[idx]
-					}
-					if work.compE != nil {
-						retE = &work.compE[idx]
-					}
-					if work.compF != nil {
-						retF = &work.compF[idx]
-					}
-					if work.compG != nil {
-						retG = &work.compG[idx]
-					}
-					if work.compH != nil {
-						retH = &work.compH[idx]
-					}
-					if work.compI != nil {
-						retI = &work.compI[idx]
-					}
-					if work.compJ != nil {
-						retJ = &work.compJ[idx]
-					}
-					if work.compK != nil {
-						retK = &work.compK[idx]
-					}
-					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
-				}
-			}
-		}()
-	}
-
-	// 3. Greedy divide work among N threads
-	// Simple algorithm:
-	// a. Find an evenly balanced distribution per thread
-	// b. Generate all work until it gets consumed
-	workPerThread := totalWork / numThreads
-
-	// Generate
-
-	var compA []A
-
-	var compB []B
-
-	var compC []C
-
-	var compD []D
-
-	var compE []E
-
-	var compF []F
-
-	var compG []G
-
-	var compH []H
-
-	var compI []I
-
-	var compJ []J
-
-	var compK []K
-
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
-		}
-		ids := lookup.id
-
-		sliceA, _ = v.storageA.slice.Get(archId)
-		sliceB, _ = v.storageB.slice.Get(archId)
-		sliceC, _ = v.storageC.slice.Get(archId)
-		sliceD, _ = v.storageD.slice.Get(archId)
-		sliceE, _ = v.storageE.slice.Get(archId)
-		sliceF, _ = v.storageF.slice.Get(archId)
-		sliceG, _ = v.storageG.slice.Get(archId)
-		sliceH, _ = v.storageH.slice.Get(archId)
-		sliceI, _ = v.storageI.slice.Get(archId)
-		sliceJ, _ = v.storageJ.slice.Get(archId)
-		sliceK, _ = v.storageK.slice.Get(archId)
-
-		compA = nil
-		if sliceA != nil {
-			compA = sliceA.comp
-		}
-		compB = nil
-		if sliceB != nil {
-			compB = sliceB.comp
-		}
-		compC = nil
-		if sliceC != nil {
-			compC = sliceC.comp
-		}
-		compD = nil
-		if sliceD != nil {
-			compD = sliceD.comp
-		}
-		compE = nil
-		if sliceE != nil {
-			compE = sliceE.comp
-		}
-		compF = nil
-		if sliceF != nil {
-			compF = sliceF.comp
-		}
-		compG = nil
-		if sliceG != nil {
-			compG = sliceG.comp
-		}
-		compH = nil
-		if sliceH != nil {
-			compH = sliceH.comp
-		}
-		compI = nil
-		if sliceI != nil {
-			compI = sliceI.comp
-		}
-		compJ = nil
-		if sliceJ != nil {
-			compJ = sliceJ.comp
-		}
-		compK = nil
-		if sliceK != nil {
-			compK = sliceK.comp
-		}
-
-		// workPerformed := 0
-
-		start := 0
-		end := 0
-		numWorkItems := (len(ids) / workPerThread) + 1
-		actualWorkPerThread := (len(ids) / numWorkItems) + 1
-		for i := 0; i < numWorkItems; i++ {
-			start = i * actualWorkPerThread
-			end = (i + 1) * actualWorkPerThread
-			if end > len(ids) {
-				end = len(ids)
-			}
-
-			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
-				ids: ids[start:end],
-
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
-
-				compF: compF[start:end],
-
-				compG: compG[start:end],
-
-				compH: compH[start:end],
-
-				compI: compI[start:end],
-
-				compJ: compJ[start:end],
-
-				compK: compK[start:end],
-			}
-		}
-
-		// if workPerformed != len(ids) {
-		// 	panic("wrong")
-		// }
-	}
-
-	close(workChannel)
-	waitGroup.Wait()
-}
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end), chunkOf(compG, start, end), chunkOf(compH, start, end), chunkOf(compI, start, end))
+			start = end
+		}
+	}
+}
 
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
-func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K)) {
+func (v *View9[A, B, C, D, E, F, G, H, I]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I)) {
 	v.filter.regenerate(v.world)
 
 	id := make([][]Id, 0)
@@ -5593,8 +10984,6 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id,
 	sliceListG := make([][]G, 0)
 	sliceListH := make([][]H, 0)
 	sliceListI := make([][]I, 0)
-	sliceListJ := make([][]J, 0)
-	sliceListK := make([][]K, 0)
 
 	for _, archId := range v.filter.archIds {
 
@@ -5634,14 +11023,6 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id,
 		if !ok {
 			continue
 		}
-		sliceJ, ok := v.storageJ.slice.Get(archId)
-		if !ok {
-			continue
-		}
-		sliceK, ok := v.storageK.slice.Get(archId)
-		if !ok {
-			continue
-		}
 
 		lookup := v.world.engine.lookup[archId]
 		if lookup == nil {
@@ -5661,25 +11042,24 @@ func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id,
 		sliceListG = append(sliceListG, sliceG.comp)
 		sliceListH = append(sliceListH, sliceH.comp)
 		sliceListI = append(sliceListI, sliceI.comp)
-		sliceListJ = append(sliceListJ, sliceJ.comp)
-		sliceListK = append(sliceListK, sliceK.comp)
 	}
 
 	for idx := range id {
 		lambda(id[idx],
-			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx], sliceListK[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx],
 		)
 	}
 }
 
 // --------------------------------------------------------------------------------
-// - View 12
+// - View 10
 // --------------------------------------------------------------------------------
 
 // Represents a view of data in a specific world. Provides access to the components specified in the generic block
-type View12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
+type View10[A, B, C, D, E, F, G, H, I, J any] struct {
 	world  *World
 	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
 
 	storageA *componentStorage[A]
 	storageB *componentStorage[B]
@@ -5691,18 +11071,34 @@ type View12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
 	storageH *componentStorage[H]
 	storageI *componentStorage[I]
 	storageJ *componentStorage[J]
-	storageK *componentStorage[K]
-	storageL *componentStorage[L]
 }
 
 // implement the initializer interface so that it can be automatically created and injected into systems
-func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Initialize(world *World) any {
-	// TODO: filters need to be a part of the query type
-	return Query12[A, B, C, D, E, F, G, H, I, J, K, L](world)
+// Note: Use View10F if the injected view needs filters
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) Initialize(world *World) any {
+	return Query10[A, B, C, D, E, F, G, H, I, J](world)
+}
+
+// A View10 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View10F[A, B, C, D, E, F, G, H, I, J any, QF QueryFilter] struct {
+	View10[A, B, C, D, E, F, G, H, I, J]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View10F[A, B, C, D, E, F, G, H, I, J, QF]) Initialize(world *World) any {
+	return Query10F[A, B, C, D, E, F, G, H, I, J, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query10F[A, B, C, D, E, F, G, H, I, J any, QF QueryFilter](world *World) *View10F[A, B, C, D, E, F, G, H, I, J, QF] {
+	var f QF
+	return &View10F[A, B, C, D, E, F, G, H, I, J, QF]{
+		View10: *Query10[A, B, C, D, E, F, G, H, I, J](world, f.Filters()...),
+	}
 }
 
 // Creates a View for the specified world with the specified component filters.
-func Query12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, filters ...Filter) *View12[A, B, C, D, E, F, G, H, I, J, K, L] {
+func Query10[A, B, C, D, E, F, G, H, I, J any](world *World, filters ...Filter) *View10[A, B, C, D, E, F, G, H, I, J] {
 
 	storageA := getStorage[A](world.engine)
 	storageB := getStorage[B](world.engine)
@@ -5714,43 +11110,27 @@ func Query12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, filters ...Fi
 	storageH := getStorage[H](world.engine)
 	storageI := getStorage[I](world.engine)
 	storageJ := getStorage[J](world.engine)
-	storageK := getStorage[K](world.engine)
-	storageL := getStorage[L](world.engine)
-
-	var AA A
-	var BB B
-	var CC C
-	var DD D
-	var EE E
-	var FF F
-	var GG G
-	var HH H
-	var II I
-	var JJ J
-	var KK K
-	var LL L
 
 	comps := []CompId{
 
-		name(AA),
-		name(BB),
-		name(CC),
-		name(DD),
-		name(EE),
-		name(FF),
-		name(GG),
-		name(HH),
-		name(II),
-		name(JJ),
-		name(KK),
-		name(LL),
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
+		compIdOf[G](),
+		compIdOf[H](),
+		compIdOf[I](),
+		compIdOf[J](),
 	}
 	filterList := newFilterList(comps, filters...)
 	filterList.regenerate(world)
 
-	v := &View12[A, B, C, D, E, F, G, H, I, J, K, L]{
+	v := &View10[A, B, C, D, E, F, G, H, I, J]{
 		world:  world,
 		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil || storageG.sparse != nil || storageH.sparse != nil || storageI.sparse != nil || storageJ.sparse != nil,
 
 		storageA: storageA,
 		storageB: storageB,
@@ -5762,165 +11142,5138 @@ func Query12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, filters ...Fi
 		storageH: storageH,
 		storageI: storageI,
 		storageJ: storageJ,
-		storageK: storageK,
-		storageL: storageL,
 	}
 	return v
 }
 
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
 // Reads a pointer to the underlying component at the specified id.
-// Read will return even if the specified id doesn't match the filter list
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
 // Read will return the value if it exists, else returns nil.
 // If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
-func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, *L) {
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J) {
 	if id == InvalidEntity {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 
 	loc, ok := v.world.arch.Get(id)
 	if !ok {
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
 	}
 	lookup := v.world.engine.lookup[loc.archId]
 	if lookup == nil {
 		panic("LookupList is missing!")
 	}
-	index := int(loc.index)
 
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) Get(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) First() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, *J, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) Single() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, *J, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
+}
+
+// Counts the number of entities that match this query
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) Count() int {
+	v.filter.regenerate(v.world)
+
+	total := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		total += v.filter.countArchetype(v.world.engine, lookup)
+	}
+	return total
+}
+
+// Maps the lambda function across every entity which matched the specified filters.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
 	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
 	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
 	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		// lookup, ok := v.world.engine.lookup[archId]
+		// if !ok { panic("LookupList is missing!") }
+		ids := lookup.id
+
+		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		retI = nil
+		retJ = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+			}
+			continue
+		}
+
+		for idx := range ids {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+
+			if compA != nil {
+				retA = &compA[idx]
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+			}
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View10.All
+type Row10[A, B, C, D, E, F, G, H, I, J any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+	J *J
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) All() iter.Seq2[Id, Row10[A, B, C, D, E, F, G, H, I, J]] {
+	return func(yield func(Id, Row10[A, B, C, D, E, F, G, H, I, J]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var sliceG *componentList[G]
+		var compG []G
+		sparseG := v.storageG.sparse
+
+		var sliceH *componentList[H]
+		var compH []H
+		sparseH := v.storageH.sparse
+
+		var sliceI *componentList[I]
+		var compI []I
+		sparseI := v.storageI.sparse
+
+		var sliceJ *componentList[J]
+		var compJ []J
+		sparseJ := v.storageJ.sparse
+
+		var row Row10[A, B, C, D, E, F, G, H, I, J]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+			sliceG, _ = v.storageG.slice.Get(archId)
+			sliceH, _ = v.storageH.slice.Get(archId)
+			sliceI, _ = v.storageI.slice.Get(archId)
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+
+			row = Row10[A, B, C, D, E, F, G, H, I, J]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if compG != nil {
+						row.G = &compG[idx]
+					} else if sparseG != nil {
+						row.G = sparseG.get(ids[idx])
+					}
+					if compH != nil {
+						row.H = &compH[idx]
+					} else if sparseH != nil {
+						row.H = sparseH.get(ids[idx])
+					}
+					if compI != nil {
+						row.I = &compI[idx]
+					} else if sparseI != nil {
+						row.I = sparseI.get(ids[idx])
+					}
+					if compJ != nil {
+						row.J = &compJ[idx]
+					} else if sparseJ != nil {
+						row.J = sparseJ.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if compG != nil {
+					row.G = &compG[idx]
+				}
+				if compH != nil {
+					row.H = &compH[idx]
+				}
+				if compI != nil {
+					row.I = &compI[idx]
+				}
+				if compJ != nil {
+					row.J = &compJ[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if compJ != nil {
+			retJ = &compJ[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+			if sparseJ != nil {
+				retJ = sparseJ.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if compJ != nil {
+			retJ = &compJ[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+			if sparseJ != nil {
+				retJ = sparseJ.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		retI = nil
+		retJ = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	sparseJ := v.storageJ.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
+	}
+
+	// 2. Calculate number of threads to execute with
+	numThreads := runtime.NumCPU()
+
+	// Ensure that the number of threads we plan to use is <= total amount of work
+	numThreads = min(totalWork, numThreads)
+
+	var waitGroup sync.WaitGroup
+
+	type workItem struct {
+		ids []Id
+
+		compA []A
+
+		compB []B
+
+		compC []C
+
+		compD []D
+
+		compE []E
+
+		compF []F
+
+		compG []G
+
+		compH []H
+
+		compI []I
+
+		compJ []J
+	}
+	workChannel := make(chan workItem)
+
+	for i := 0; i < numThreads; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			for {
+				work, ok := <-workChannel
+				if !ok {
+					return
+				}
+
+				var retA *A
+				var retB *B
+				var retC *C
+				var retD *D
+				var retE *E
+				var retF *F
+				var retG *G
+				var retH *H
+				var retI *I
+				var retJ *J
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						if work.compG != nil {
+							retG = &work.compG[idx]
+						} else if sparseG != nil {
+							retG = sparseG.get(work.ids[idx])
+						}
+						if work.compH != nil {
+							retH = &work.compH[idx]
+						} else if sparseH != nil {
+							retH = sparseH.get(work.ids[idx])
+						}
+						if work.compI != nil {
+							retI = &work.compI[idx]
+						} else if sparseI != nil {
+							retI = sparseI.get(work.ids[idx])
+						}
+						if work.compJ != nil {
+							retJ = &work.compJ[idx]
+						} else if sparseJ != nil {
+							retJ = sparseJ.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+					}
+					continue
+				}
+
+				for idx := range work.ids {
+					if work.ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+
+					if work.compA != nil {
+						retA = &work.compA[idx]
+					}
+					if work.compB != nil {
+						retB = &work.compB[idx]
+					}
+					if work.compC != nil {
+						retC = &work.compC[idx]
+					}
+					if work.compD != nil {
+						retD = &work.compD[idx]
+					}
+					if work.compE != nil {
+						retE = &work.compE[idx]
+					}
+					if work.compF != nil {
+						retF = &work.compF[idx]
+					}
+					if work.compG != nil {
+						retG = &work.compG[idx]
+					}
+					if work.compH != nil {
+						retH = &work.compH[idx]
+					}
+					if work.compI != nil {
+						retI = &work.compI[idx]
+					}
+					if work.compJ != nil {
+						retJ = &work.compJ[idx]
+					}
+					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ)
+				}
+			}
+		}()
+	}
+
+	// 3. Greedy divide work among N threads
+	// Simple algorithm:
+	// a. Find an evenly balanced distribution per thread
+	// b. Generate all work until it gets consumed
+	workPerThread := totalWork / numThreads
+
+	// Generate
+
+	var compA []A
+
+	var compB []B
+
+	var compC []C
+
+	var compD []D
+
+	var compE []E
+
+	var compF []F
+
+	var compG []G
+
+	var compH []H
+
+	var compI []I
+
+	var compJ []J
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+
+		// workPerformed := 0
+
+		start := 0
+		end := 0
+		numWorkItems := (len(ids) / workPerThread) + 1
+		actualWorkPerThread := (len(ids) / numWorkItems) + 1
+		for i := 0; i < numWorkItems; i++ {
+			start = i * actualWorkPerThread
+			end = (i + 1) * actualWorkPerThread
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			// workPerformed += len(ids[start:end])
+			item := workItem{
+				ids: ids[start:end],
+			}
+
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			if compF != nil {
+				item.compF = compF[start:end]
+			}
+			if compG != nil {
+				item.compG = compG[start:end]
+			}
+			if compH != nil {
+				item.compH = compH[start:end]
+			}
+			if compI != nil {
+				item.compI = compI[start:end]
+			}
+			if compJ != nil {
+				item.compJ = compJ[start:end]
+			}
+			workChannel <- item
+		}
+
+		// if workPerformed != len(ids) {
+		// 	panic("wrong")
+		// }
+	}
+
+	close(workChannel)
+	waitGroup.Wait()
+}
+
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
+	var compG []G
+	var compH []H
+	var compI []I
+	var compJ []J
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG, ok := v.storageG.slice.Get(archId); ok {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH, ok := v.storageH.slice.Get(archId); ok {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI, ok := v.storageI.slice.Get(archId); ok {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ, ok := v.storageJ.slice.Get(archId); ok {
+			compJ = sliceJ.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end), chunkOf(compG, start, end), chunkOf(compH, start, end), chunkOf(compI, start, end), chunkOf(compJ, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
+// Deprecated: This API is a tentative alternative way to map
+func (v *View10[A, B, C, D, E, F, G, H, I, J]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J)) {
+	v.filter.regenerate(v.world)
+
+	id := make([][]Id, 0)
+
+	sliceListA := make([][]A, 0)
+	sliceListB := make([][]B, 0)
+	sliceListC := make([][]C, 0)
+	sliceListD := make([][]D, 0)
+	sliceListE := make([][]E, 0)
+	sliceListF := make([][]F, 0)
+	sliceListG := make([][]G, 0)
+	sliceListH := make([][]H, 0)
+	sliceListI := make([][]I, 0)
+	sliceListJ := make([][]J, 0)
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, ok := v.storageA.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceB, ok := v.storageB.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceC, ok := v.storageC.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceD, ok := v.storageD.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceE, ok := v.storageE.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceF, ok := v.storageF.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceG, ok := v.storageG.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceH, ok := v.storageH.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceI, ok := v.storageI.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceJ, ok := v.storageJ.slice.Get(archId)
+		if !ok {
+			continue
+		}
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		// lookup, ok := v.world.engine.lookup[archId]
+		// if !ok { panic("LookupList is missing!") }
+
+		id = append(id, lookup.id)
+
+		sliceListA = append(sliceListA, sliceA.comp)
+		sliceListB = append(sliceListB, sliceB.comp)
+		sliceListC = append(sliceListC, sliceC.comp)
+		sliceListD = append(sliceListD, sliceD.comp)
+		sliceListE = append(sliceListE, sliceE.comp)
+		sliceListF = append(sliceListF, sliceF.comp)
+		sliceListG = append(sliceListG, sliceG.comp)
+		sliceListH = append(sliceListH, sliceH.comp)
+		sliceListI = append(sliceListI, sliceI.comp)
+		sliceListJ = append(sliceListJ, sliceJ.comp)
+	}
+
+	for idx := range id {
+		lambda(id[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx],
+		)
+	}
+}
+
+// --------------------------------------------------------------------------------
+// - View 11
+// --------------------------------------------------------------------------------
+
+// Represents a view of data in a specific world. Provides access to the components specified in the generic block
+type View11[A, B, C, D, E, F, G, H, I, J, K any] struct {
+	world  *World
+	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
+
+	storageA *componentStorage[A]
+	storageB *componentStorage[B]
+	storageC *componentStorage[C]
+	storageD *componentStorage[D]
+	storageE *componentStorage[E]
+	storageF *componentStorage[F]
+	storageG *componentStorage[G]
+	storageH *componentStorage[H]
+	storageI *componentStorage[I]
+	storageJ *componentStorage[J]
+	storageK *componentStorage[K]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View11F if the injected view needs filters
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Initialize(world *World) any {
+	return Query11[A, B, C, D, E, F, G, H, I, J, K](world)
+}
+
+// A View11 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View11F[A, B, C, D, E, F, G, H, I, J, K any, QF QueryFilter] struct {
+	View11[A, B, C, D, E, F, G, H, I, J, K]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View11F[A, B, C, D, E, F, G, H, I, J, K, QF]) Initialize(world *World) any {
+	return Query11F[A, B, C, D, E, F, G, H, I, J, K, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query11F[A, B, C, D, E, F, G, H, I, J, K any, QF QueryFilter](world *World) *View11F[A, B, C, D, E, F, G, H, I, J, K, QF] {
+	var f QF
+	return &View11F[A, B, C, D, E, F, G, H, I, J, K, QF]{
+		View11: *Query11[A, B, C, D, E, F, G, H, I, J, K](world, f.Filters()...),
+	}
+}
+
+// Creates a View for the specified world with the specified component filters.
+func Query11[A, B, C, D, E, F, G, H, I, J, K any](world *World, filters ...Filter) *View11[A, B, C, D, E, F, G, H, I, J, K] {
+
+	storageA := getStorage[A](world.engine)
+	storageB := getStorage[B](world.engine)
+	storageC := getStorage[C](world.engine)
+	storageD := getStorage[D](world.engine)
+	storageE := getStorage[E](world.engine)
+	storageF := getStorage[F](world.engine)
+	storageG := getStorage[G](world.engine)
+	storageH := getStorage[H](world.engine)
+	storageI := getStorage[I](world.engine)
+	storageJ := getStorage[J](world.engine)
+	storageK := getStorage[K](world.engine)
+
+	comps := []CompId{
+
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
+		compIdOf[G](),
+		compIdOf[H](),
+		compIdOf[I](),
+		compIdOf[J](),
+		compIdOf[K](),
+	}
+	filterList := newFilterList(comps, filters...)
+	filterList.regenerate(world)
+
+	v := &View11[A, B, C, D, E, F, G, H, I, J, K]{
+		world:  world,
+		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil || storageG.sparse != nil || storageH.sparse != nil || storageI.sparse != nil || storageJ.sparse != nil || storageK.sparse != nil,
+
+		storageA: storageA,
+		storageB: storageB,
+		storageC: storageC,
+		storageD: storageD,
+		storageE: storageE,
+		storageF: storageF,
+		storageG: storageG,
+		storageH: storageH,
+		storageI: storageI,
+		storageJ: storageJ,
+		storageK: storageK,
+	}
+	return v
+}
+
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
+// Reads a pointer to the underlying component at the specified id.
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
+// Read will return the value if it exists, else returns nil.
+// If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K) {
+	if id == InvalidEntity {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	loc, ok := v.world.arch.Get(id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	lookup := v.world.engine.lookup[loc.archId]
+	if lookup == nil {
+		panic("LookupList is missing!")
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+	retK := v.storageK.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Get(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+	retK := v.storageK.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) First() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+	retK := v.storageK.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Single() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
+}
+
+// Counts the number of entities that match this query
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) Count() int {
+	v.filter.regenerate(v.world)
+
+	total := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		total += v.filter.countArchetype(v.world.engine, lookup)
+	}
+	return total
+}
+
+// Maps the lambda function across every entity which matched the specified filters.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+		sliceK, _ = v.storageK.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		// lookup, ok := v.world.engine.lookup[archId]
+		// if !ok { panic("LookupList is missing!") }
+		ids := lookup.id
+
+		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+		compK = nil
+		if sliceK != nil {
+			compK = sliceK.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		retI = nil
+		retJ = nil
+		retK = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				} else if sparseK != nil {
+					retK = sparseK.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+			}
+			continue
+		}
+
+		for idx := range ids {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+
+			if compA != nil {
+				retA = &compA[idx]
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+			}
+			if compK != nil {
+				retK = &compK[idx]
+			}
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View11.All
+type Row11[A, B, C, D, E, F, G, H, I, J, K any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+	J *J
+	K *K
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) All() iter.Seq2[Id, Row11[A, B, C, D, E, F, G, H, I, J, K]] {
+	return func(yield func(Id, Row11[A, B, C, D, E, F, G, H, I, J, K]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var sliceG *componentList[G]
+		var compG []G
+		sparseG := v.storageG.sparse
+
+		var sliceH *componentList[H]
+		var compH []H
+		sparseH := v.storageH.sparse
+
+		var sliceI *componentList[I]
+		var compI []I
+		sparseI := v.storageI.sparse
+
+		var sliceJ *componentList[J]
+		var compJ []J
+		sparseJ := v.storageJ.sparse
+
+		var sliceK *componentList[K]
+		var compK []K
+		sparseK := v.storageK.sparse
+
+		var row Row11[A, B, C, D, E, F, G, H, I, J, K]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+			sliceG, _ = v.storageG.slice.Get(archId)
+			sliceH, _ = v.storageH.slice.Get(archId)
+			sliceI, _ = v.storageI.slice.Get(archId)
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			sliceK, _ = v.storageK.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+
+			row = Row11[A, B, C, D, E, F, G, H, I, J, K]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if compG != nil {
+						row.G = &compG[idx]
+					} else if sparseG != nil {
+						row.G = sparseG.get(ids[idx])
+					}
+					if compH != nil {
+						row.H = &compH[idx]
+					} else if sparseH != nil {
+						row.H = sparseH.get(ids[idx])
+					}
+					if compI != nil {
+						row.I = &compI[idx]
+					} else if sparseI != nil {
+						row.I = sparseI.get(ids[idx])
+					}
+					if compJ != nil {
+						row.J = &compJ[idx]
+					} else if sparseJ != nil {
+						row.J = sparseJ.get(ids[idx])
+					}
+					if compK != nil {
+						row.K = &compK[idx]
+					} else if sparseK != nil {
+						row.K = sparseK.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if compG != nil {
+					row.G = &compG[idx]
+				}
+				if compH != nil {
+					row.H = &compH[idx]
+				}
+				if compI != nil {
+					row.I = &compI[idx]
+				}
+				if compJ != nil {
+					row.J = &compJ[idx]
+				}
+				if compK != nil {
+					row.K = &compK[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			sliceK, _ = v.storageK.slice.Get(archId)
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+			retK = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if compJ != nil {
+			retJ = &compJ[idx]
+		}
+		if compK != nil {
+			retK = &compK[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+			if sparseJ != nil {
+				retJ = sparseJ.get(row.id)
+			}
+			if sparseK != nil {
+				retK = sparseK.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			sliceK, _ = v.storageK.slice.Get(archId)
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+			retK = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if compJ != nil {
+			retJ = &compJ[idx]
+		}
+		if compK != nil {
+			retK = &compK[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+			if sparseJ != nil {
+				retJ = sparseJ.get(row.id)
+			}
+			if sparseK != nil {
+				retK = sparseK.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+	}
+}
+
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) bool {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
+
+	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+		sliceK, _ = v.storageK.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+		compK = nil
+		if sliceK != nil {
+			compK = sliceK.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		retI = nil
+		retJ = nil
+		retK = nil
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				} else if sparseK != nil {
+					retK = sparseK.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+				cursor.visited++
+			}
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+				cursor.visited++
+			}
+		}
+		cursor.index = len(ids)
+	}
+
+	cursor.Reset()
+	return true
+}
+
+// Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	sparseK := v.storageK.sparse
+
+	// 1. Calculate work
+	// 2. Calculate number of threads to execute with
+	// 3. Greedy divide work among N threads
+	// 4. Execute for each in its own goroutine
+
+	// 1. Calculate work
+	totalWork := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		// Each id represents an entity that holds the requested component(s)
+		// Each hole represents a deleted entity that used to hold the requested component(s)
+		totalWork += len(lookup.id) // - len(lookup.holes)
+	}
+
+	// Nothing to do if there is no work
+	if totalWork == 0 {
+		return
+	}
+
+	// 2. Calculate number of threads to execute with
+	numThreads := runtime.NumCPU()
+
+	// Ensure that the number of threads we plan to use is <= total amount of work
+	numThreads = min(totalWork, numThreads)
+
+	var waitGroup sync.WaitGroup
+
+	type workItem struct {
+		ids []Id
+
+		compA []A
+
+		compB []B
+
+		compC []C
+
+		compD []D
+
+		compE []E
+
+		compF []F
+
+		compG []G
+
+		compH []H
+
+		compI []I
+
+		compJ []J
+
+		compK []K
+	}
+	workChannel := make(chan workItem)
+
+	for i := 0; i < numThreads; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			for {
+				work, ok := <-workChannel
+				if !ok {
+					return
+				}
+
+				var retA *A
+				var retB *B
+				var retC *C
+				var retD *D
+				var retE *E
+				var retF *F
+				var retG *G
+				var retH *H
+				var retI *I
+				var retJ *J
+				var retK *K
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						if work.compG != nil {
+							retG = &work.compG[idx]
+						} else if sparseG != nil {
+							retG = sparseG.get(work.ids[idx])
+						}
+						if work.compH != nil {
+							retH = &work.compH[idx]
+						} else if sparseH != nil {
+							retH = sparseH.get(work.ids[idx])
+						}
+						if work.compI != nil {
+							retI = &work.compI[idx]
+						} else if sparseI != nil {
+							retI = sparseI.get(work.ids[idx])
+						}
+						if work.compJ != nil {
+							retJ = &work.compJ[idx]
+						} else if sparseJ != nil {
+							retJ = sparseJ.get(work.ids[idx])
+						}
+						if work.compK != nil {
+							retK = &work.compK[idx]
+						} else if sparseK != nil {
+							retK = sparseK.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+					}
+					continue
+				}
+
+				for idx := range work.ids {
+					if work.ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+
+					if work.compA != nil {
+						retA = &work.compA[idx]
+					}
+					if work.compB != nil {
+						retB = &work.compB[idx]
+					}
+					if work.compC != nil {
+						retC = &work.compC[idx]
+					}
+					if work.compD != nil {
+						retD = &work.compD[idx]
+					}
+					if work.compE != nil {
+						retE = &work.compE[idx]
+					}
+					if work.compF != nil {
+						retF = &work.compF[idx]
+					}
+					if work.compG != nil {
+						retG = &work.compG[idx]
+					}
+					if work.compH != nil {
+						retH = &work.compH[idx]
+					}
+					if work.compI != nil {
+						retI = &work.compI[idx]
+					}
+					if work.compJ != nil {
+						retJ = &work.compJ[idx]
+					}
+					if work.compK != nil {
+						retK = &work.compK[idx]
+					}
+					lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK)
+				}
+			}
+		}()
+	}
+
+	// 3. Greedy divide work among N threads
+	// Simple algorithm:
+	// a. Find an evenly balanced distribution per thread
+	// b. Generate all work until it gets consumed
+	workPerThread := totalWork / numThreads
+
+	// Generate
+
+	var compA []A
+
+	var compB []B
+
+	var compC []C
+
+	var compD []D
+
+	var compE []E
+
+	var compF []F
+
+	var compG []G
+
+	var compH []H
+
+	var compI []I
+
+	var compJ []J
+
+	var compK []K
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+		sliceK, _ = v.storageK.slice.Get(archId)
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+		compK = nil
+		if sliceK != nil {
+			compK = sliceK.comp
+		}
+
+		// workPerformed := 0
+
+		start := 0
+		end := 0
+		numWorkItems := (len(ids) / workPerThread) + 1
+		actualWorkPerThread := (len(ids) / numWorkItems) + 1
+		for i := 0; i < numWorkItems; i++ {
+			start = i * actualWorkPerThread
+			end = (i + 1) * actualWorkPerThread
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			// workPerformed += len(ids[start:end])
+			item := workItem{
+				ids: ids[start:end],
+			}
+
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			if compF != nil {
+				item.compF = compF[start:end]
+			}
+			if compG != nil {
+				item.compG = compG[start:end]
+			}
+			if compH != nil {
+				item.compH = compH[start:end]
+			}
+			if compI != nil {
+				item.compI = compI[start:end]
+			}
+			if compJ != nil {
+				item.compJ = compJ[start:end]
+			}
+			if compK != nil {
+				item.compK = compK[start:end]
+			}
+			workChannel <- item
+		}
+
+		// if workPerformed != len(ids) {
+		// 	panic("wrong")
+		// }
+	}
+
+	close(workChannel)
+	waitGroup.Wait()
+}
+
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
+	var compG []G
+	var compH []H
+	var compI []I
+	var compJ []J
+	var compK []K
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG, ok := v.storageG.slice.Get(archId); ok {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH, ok := v.storageH.slice.Get(archId); ok {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI, ok := v.storageI.slice.Get(archId); ok {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ, ok := v.storageJ.slice.Get(archId); ok {
+			compJ = sliceJ.comp
+		}
+		compK = nil
+		if sliceK, ok := v.storageK.slice.Get(archId); ok {
+			compK = sliceK.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end), chunkOf(compG, start, end), chunkOf(compH, start, end), chunkOf(compI, start, end), chunkOf(compJ, start, end), chunkOf(compK, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
+// Deprecated: This API is a tentative alternative way to map
+func (v *View11[A, B, C, D, E, F, G, H, I, J, K]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K)) {
+	v.filter.regenerate(v.world)
+
+	id := make([][]Id, 0)
+
+	sliceListA := make([][]A, 0)
+	sliceListB := make([][]B, 0)
+	sliceListC := make([][]C, 0)
+	sliceListD := make([][]D, 0)
+	sliceListE := make([][]E, 0)
+	sliceListF := make([][]F, 0)
+	sliceListG := make([][]G, 0)
+	sliceListH := make([][]H, 0)
+	sliceListI := make([][]I, 0)
+	sliceListJ := make([][]J, 0)
+	sliceListK := make([][]K, 0)
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, ok := v.storageA.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceB, ok := v.storageB.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceC, ok := v.storageC.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceD, ok := v.storageD.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceE, ok := v.storageE.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceF, ok := v.storageF.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceG, ok := v.storageG.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceH, ok := v.storageH.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceI, ok := v.storageI.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceJ, ok := v.storageJ.slice.Get(archId)
+		if !ok {
+			continue
+		}
+		sliceK, ok := v.storageK.slice.Get(archId)
+		if !ok {
+			continue
+		}
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		// lookup, ok := v.world.engine.lookup[archId]
+		// if !ok { panic("LookupList is missing!") }
+
+		id = append(id, lookup.id)
+
+		sliceListA = append(sliceListA, sliceA.comp)
+		sliceListB = append(sliceListB, sliceB.comp)
+		sliceListC = append(sliceListC, sliceC.comp)
+		sliceListD = append(sliceListD, sliceD.comp)
+		sliceListE = append(sliceListE, sliceE.comp)
+		sliceListF = append(sliceListF, sliceF.comp)
+		sliceListG = append(sliceListG, sliceG.comp)
+		sliceListH = append(sliceListH, sliceH.comp)
+		sliceListI = append(sliceListI, sliceI.comp)
+		sliceListJ = append(sliceListJ, sliceJ.comp)
+		sliceListK = append(sliceListK, sliceK.comp)
+	}
+
+	for idx := range id {
+		lambda(id[idx],
+			sliceListA[idx], sliceListB[idx], sliceListC[idx], sliceListD[idx], sliceListE[idx], sliceListF[idx], sliceListG[idx], sliceListH[idx], sliceListI[idx], sliceListJ[idx], sliceListK[idx],
+		)
+	}
+}
+
+// --------------------------------------------------------------------------------
+// - View 12
+// --------------------------------------------------------------------------------
+
+// Represents a view of data in a specific world. Provides access to the components specified in the generic block
+type View12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
+	world  *World
+	filter filterList
+	sparse bool // True if any of the components or filters are sparse, which needs the slower loops that look them up by id
+
+	storageA *componentStorage[A]
+	storageB *componentStorage[B]
+	storageC *componentStorage[C]
+	storageD *componentStorage[D]
+	storageE *componentStorage[E]
+	storageF *componentStorage[F]
+	storageG *componentStorage[G]
+	storageH *componentStorage[H]
+	storageI *componentStorage[I]
+	storageJ *componentStorage[J]
+	storageK *componentStorage[K]
+	storageL *componentStorage[L]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+// Note: Use View12F if the injected view needs filters
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Initialize(world *World) any {
+	return Query12[A, B, C, D, E, F, G, H, I, J, K, L](world)
+}
+
+// A View12 which has its filters specified by the type QF. It has all of the same methods, and can be injected into systems with its filters
+type View12F[A, B, C, D, E, F, G, H, I, J, K, L any, QF QueryFilter] struct {
+	View12[A, B, C, D, E, F, G, H, I, J, K, L]
+}
+
+// implement the initializer interface so that it can be automatically created and injected into systems
+func (v *View12F[A, B, C, D, E, F, G, H, I, J, K, L, QF]) Initialize(world *World) any {
+	return Query12F[A, B, C, D, E, F, G, H, I, J, K, L, QF](world)
+}
+
+// Creates a View for the specified world with the filters described by QF
+func Query12F[A, B, C, D, E, F, G, H, I, J, K, L any, QF QueryFilter](world *World) *View12F[A, B, C, D, E, F, G, H, I, J, K, L, QF] {
+	var f QF
+	return &View12F[A, B, C, D, E, F, G, H, I, J, K, L, QF]{
+		View12: *Query12[A, B, C, D, E, F, G, H, I, J, K, L](world, f.Filters()...),
+	}
+}
+
+// Creates a View for the specified world with the specified component filters.
+func Query12[A, B, C, D, E, F, G, H, I, J, K, L any](world *World, filters ...Filter) *View12[A, B, C, D, E, F, G, H, I, J, K, L] {
+
+	storageA := getStorage[A](world.engine)
+	storageB := getStorage[B](world.engine)
+	storageC := getStorage[C](world.engine)
+	storageD := getStorage[D](world.engine)
+	storageE := getStorage[E](world.engine)
+	storageF := getStorage[F](world.engine)
+	storageG := getStorage[G](world.engine)
+	storageH := getStorage[H](world.engine)
+	storageI := getStorage[I](world.engine)
+	storageJ := getStorage[J](world.engine)
+	storageK := getStorage[K](world.engine)
+	storageL := getStorage[L](world.engine)
+
+	comps := []CompId{
+
+		compIdOf[A](),
+		compIdOf[B](),
+		compIdOf[C](),
+		compIdOf[D](),
+		compIdOf[E](),
+		compIdOf[F](),
+		compIdOf[G](),
+		compIdOf[H](),
+		compIdOf[I](),
+		compIdOf[J](),
+		compIdOf[K](),
+		compIdOf[L](),
+	}
+	filterList := newFilterList(comps, filters...)
+	filterList.regenerate(world)
+
+	v := &View12[A, B, C, D, E, F, G, H, I, J, K, L]{
+		world:  world,
+		filter: filterList,
+		sparse: filterList.hasSparse() || storageA.sparse != nil || storageB.sparse != nil || storageC.sparse != nil || storageD.sparse != nil || storageE.sparse != nil || storageF.sparse != nil || storageG.sparse != nil || storageH.sparse != nil || storageI.sparse != nil || storageJ.sparse != nil || storageK.sparse != nil || storageL.sparse != nil,
+
+		storageA: storageA,
+		storageB: storageB,
+		storageC: storageC,
+		storageD: storageD,
+		storageE: storageE,
+		storageF: storageF,
+		storageG: storageG,
+		storageH: storageH,
+		storageI: storageI,
+		storageJ: storageJ,
+		storageK: storageK,
+		storageL: storageL,
+	}
+	return v
+}
+
+// implement the AnyView interface so that the view can be used for bulk operations
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) viewFilter() (*World, *filterList) {
+	return v.world, &v.filter
+}
+
+// Reads a pointer to the underlying component at the specified id.
+// Read will return even if the specified id doesn't match the filter list, use Get if you only want entities that match
+// Read will return the value if it exists, else returns nil.
+// If you execute any ecs.Write(...) or ecs.Delete(...) this pointer may become invalid.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Read(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, *L) {
+	if id == InvalidEntity {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+
+	loc, ok := v.world.arch.Get(id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil
+	}
+	lookup := v.world.engine.lookup[loc.archId]
+	if lookup == nil {
+		panic("LookupList is missing!")
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+	retK := v.storageK.get(loc, id)
+	retL := v.storageL.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL
+}
+
+// Returns true if the entity exists and matches the view's filters
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Matches(id Id) bool {
+	_, ok := v.filter.matches(v.world, id)
+	return ok
+}
+
+// Reads pointers to the underlying components at the specified id, but only if the entity matches the view's filters.
+// Returns false (and nil pointers) if the entity doesn't exist or doesn't match. Components marked as Optional can still be nil on a match.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Get(id Id) (*A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, *L, bool) {
+	loc, ok := v.filter.matches(v.world, id)
+	if !ok {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+	retK := v.storageK.get(loc, id)
+	retL := v.storageL.get(loc, id)
+
+	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL, true
+}
+
+// Returns the first entity that matches the view, and pointers to its components. Returns false if nothing matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) First() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, *L, bool) {
+	id, loc, ok := v.filter.first(v.world)
+	if !ok {
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false
+	}
+
+	retA := v.storageA.get(loc, id)
+	retB := v.storageB.get(loc, id)
+	retC := v.storageC.get(loc, id)
+	retD := v.storageD.get(loc, id)
+	retE := v.storageE.get(loc, id)
+	retF := v.storageF.get(loc, id)
+	retG := v.storageG.get(loc, id)
+	retH := v.storageH.get(loc, id)
+	retI := v.storageI.get(loc, id)
+	retJ := v.storageJ.get(loc, id)
+	retK := v.storageK.get(loc, id)
+	retL := v.storageL.get(loc, id)
+
+	return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL, true
+}
+
+// Returns the only entity that matches the view, and pointers to its components. This is useful for singletons like the player or the camera.
+// Returns ErrNoMatch if nothing matches, or ErrMultipleMatches if more than one entity matches.
+// If you execute any ecs.Write(...) or ecs.Delete(...) these pointers may become invalid.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Single() (Id, *A, *B, *C, *D, *E, *F, *G, *H, *I, *J, *K, *L, error) {
+	switch v.Count() {
+	case 0:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrNoMatch
+	case 1:
+		id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL, _ := v.First()
+		return id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL, nil
+	default:
+		return InvalidEntity, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ErrMultipleMatches
+	}
+}
+
+// Counts the number of entities that match this query
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Count() int {
+	v.filter.regenerate(v.world)
+
+	total := 0
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+
+		total += v.filter.countArchetype(v.world.engine, lookup)
+	}
+	return total
+}
+
+// Maps the lambda function across every entity which matched the specified filters.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
+
+	var sliceL *componentList[L]
+	var compL []L
+	var retL *L
+	sparseL := v.storageL.sparse
+
+	for _, archId := range v.filter.archIds {
+
+		sliceA, _ = v.storageA.slice.Get(archId)
+		sliceB, _ = v.storageB.slice.Get(archId)
+		sliceC, _ = v.storageC.slice.Get(archId)
+		sliceD, _ = v.storageD.slice.Get(archId)
+		sliceE, _ = v.storageE.slice.Get(archId)
+		sliceF, _ = v.storageF.slice.Get(archId)
+		sliceG, _ = v.storageG.slice.Get(archId)
+		sliceH, _ = v.storageH.slice.Get(archId)
+		sliceI, _ = v.storageI.slice.Get(archId)
+		sliceJ, _ = v.storageJ.slice.Get(archId)
+		sliceK, _ = v.storageK.slice.Get(archId)
+		sliceL, _ = v.storageL.slice.Get(archId)
+
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		// lookup, ok := v.world.engine.lookup[archId]
+		// if !ok { panic("LookupList is missing!") }
+		ids := lookup.id
+
+		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
+
+		compA = nil
+		if sliceA != nil {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB != nil {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC != nil {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD != nil {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE != nil {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF != nil {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG != nil {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH != nil {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI != nil {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ != nil {
+			compJ = sliceJ.comp
+		}
+		compK = nil
+		if sliceK != nil {
+			compK = sliceK.comp
+		}
+		compL = nil
+		if sliceL != nil {
+			compL = sliceL.comp
+		}
+
+		retA = nil
+		retB = nil
+		retC = nil
+		retD = nil
+		retE = nil
+		retF = nil
+		retG = nil
+		retH = nil
+		retI = nil
+		retJ = nil
+		retK = nil
+		retL = nil
+		if v.sparse {
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				} else if sparseK != nil {
+					retK = sparseK.get(ids[idx])
+				}
+				if compL != nil {
+					retL = &compL[idx]
+				} else if sparseL != nil {
+					retL = sparseL.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+			}
+			continue
+		}
+
+		for idx := range ids {
+			if ids[idx] == InvalidEntity {
+				continue
+			} // Skip if its a hole
+
+			if compA != nil {
+				retA = &compA[idx]
+			}
+			if compB != nil {
+				retB = &compB[idx]
+			}
+			if compC != nil {
+				retC = &compC[idx]
+			}
+			if compD != nil {
+				retD = &compD[idx]
+			}
+			if compE != nil {
+				retE = &compE[idx]
+			}
+			if compF != nil {
+				retF = &compF[idx]
+			}
+			if compG != nil {
+				retG = &compG[idx]
+			}
+			if compH != nil {
+				retH = &compH[idx]
+			}
+			if compI != nil {
+				retI = &compI[idx]
+			}
+			if compJ != nil {
+				retJ = &compJ[idx]
+			}
+			if compK != nil {
+				retK = &compK[idx]
+			}
+			if compL != nil {
+				retL = &compL[idx]
+			}
+			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+		}
+	}
+}
+
+// Holds pointers to the components of a single entity, this is yielded by View12.All
+type Row12[A, B, C, D, E, F, G, H, I, J, K, L any] struct {
+	A *A
+	B *B
+	C *C
+	D *D
+	E *E
+	F *F
+	G *G
+	H *H
+	I *I
+	J *J
+	K *K
+	L *L
+}
+
+// Returns an iterator over every entity which matched the specified filters, along with pointers to its components.
+// You can break out of the loop early. The pointers are only valid until the next ecs.Write(...) or ecs.Delete(...)
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) All() iter.Seq2[Id, Row12[A, B, C, D, E, F, G, H, I, J, K, L]] {
+	return func(yield func(Id, Row12[A, B, C, D, E, F, G, H, I, J, K, L]) bool) {
+		v.filter.regenerate(v.world)
+		v.world.engine.beginIteration()
+		defer v.world.engine.endIteration()
+
+		var sliceA *componentList[A]
+		var compA []A
+		sparseA := v.storageA.sparse
+
+		var sliceB *componentList[B]
+		var compB []B
+		sparseB := v.storageB.sparse
+
+		var sliceC *componentList[C]
+		var compC []C
+		sparseC := v.storageC.sparse
+
+		var sliceD *componentList[D]
+		var compD []D
+		sparseD := v.storageD.sparse
+
+		var sliceE *componentList[E]
+		var compE []E
+		sparseE := v.storageE.sparse
+
+		var sliceF *componentList[F]
+		var compF []F
+		sparseF := v.storageF.sparse
+
+		var sliceG *componentList[G]
+		var compG []G
+		sparseG := v.storageG.sparse
+
+		var sliceH *componentList[H]
+		var compH []H
+		sparseH := v.storageH.sparse
+
+		var sliceI *componentList[I]
+		var compI []I
+		sparseI := v.storageI.sparse
+
+		var sliceJ *componentList[J]
+		var compJ []J
+		sparseJ := v.storageJ.sparse
+
+		var sliceK *componentList[K]
+		var compK []K
+		sparseK := v.storageK.sparse
+
+		var sliceL *componentList[L]
+		var compL []L
+		sparseL := v.storageL.sparse
+
+		var row Row12[A, B, C, D, E, F, G, H, I, J, K, L]
+		for _, archId := range v.filter.archIds {
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			sliceB, _ = v.storageB.slice.Get(archId)
+			sliceC, _ = v.storageC.slice.Get(archId)
+			sliceD, _ = v.storageD.slice.Get(archId)
+			sliceE, _ = v.storageE.slice.Get(archId)
+			sliceF, _ = v.storageF.slice.Get(archId)
+			sliceG, _ = v.storageG.slice.Get(archId)
+			sliceH, _ = v.storageH.slice.Get(archId)
+			sliceI, _ = v.storageI.slice.Get(archId)
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			sliceK, _ = v.storageK.slice.Get(archId)
+			sliceL, _ = v.storageL.slice.Get(archId)
+
+			lookup := v.world.engine.lookup[archId]
+			if lookup == nil {
+				panic("LookupList is missing!")
+			}
+			ids := lookup.id
+
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+			compL = nil
+			if sliceL != nil {
+				compL = sliceL.comp
+			}
+
+			row = Row12[A, B, C, D, E, F, G, H, I, J, K, L]{}
+			if v.sparse {
+				for idx := range ids {
+					if ids[idx] == InvalidEntity {
+						continue
+					} // Skip if its a hole
+					if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+						continue
+					} // Skip if the sparse components dont match
+
+					if compA != nil {
+						row.A = &compA[idx]
+					} else if sparseA != nil {
+						row.A = sparseA.get(ids[idx])
+					}
+					if compB != nil {
+						row.B = &compB[idx]
+					} else if sparseB != nil {
+						row.B = sparseB.get(ids[idx])
+					}
+					if compC != nil {
+						row.C = &compC[idx]
+					} else if sparseC != nil {
+						row.C = sparseC.get(ids[idx])
+					}
+					if compD != nil {
+						row.D = &compD[idx]
+					} else if sparseD != nil {
+						row.D = sparseD.get(ids[idx])
+					}
+					if compE != nil {
+						row.E = &compE[idx]
+					} else if sparseE != nil {
+						row.E = sparseE.get(ids[idx])
+					}
+					if compF != nil {
+						row.F = &compF[idx]
+					} else if sparseF != nil {
+						row.F = sparseF.get(ids[idx])
+					}
+					if compG != nil {
+						row.G = &compG[idx]
+					} else if sparseG != nil {
+						row.G = sparseG.get(ids[idx])
+					}
+					if compH != nil {
+						row.H = &compH[idx]
+					} else if sparseH != nil {
+						row.H = sparseH.get(ids[idx])
+					}
+					if compI != nil {
+						row.I = &compI[idx]
+					} else if sparseI != nil {
+						row.I = sparseI.get(ids[idx])
+					}
+					if compJ != nil {
+						row.J = &compJ[idx]
+					} else if sparseJ != nil {
+						row.J = sparseJ.get(ids[idx])
+					}
+					if compK != nil {
+						row.K = &compK[idx]
+					} else if sparseK != nil {
+						row.K = sparseK.get(ids[idx])
+					}
+					if compL != nil {
+						row.L = &compL[idx]
+					} else if sparseL != nil {
+						row.L = sparseL.get(ids[idx])
+					}
+					if !yield(ids[idx], row) {
+						return
+					}
+				}
+				continue
+			}
+
+			for idx := range ids {
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					row.A = &compA[idx]
+				}
+				if compB != nil {
+					row.B = &compB[idx]
+				}
+				if compC != nil {
+					row.C = &compC[idx]
+				}
+				if compD != nil {
+					row.D = &compD[idx]
+				}
+				if compE != nil {
+					row.E = &compE[idx]
+				}
+				if compF != nil {
+					row.F = &compF[idx]
+				}
+				if compG != nil {
+					row.G = &compG[idx]
+				}
+				if compH != nil {
+					row.H = &compH[idx]
+				}
+				if compI != nil {
+					row.I = &compI[idx]
+				}
+				if compJ != nil {
+					row.J = &compJ[idx]
+				}
+				if compK != nil {
+					row.K = &compK[idx]
+				}
+				if compL != nil {
+					row.L = &compL[idx]
+				}
+				if !yield(ids[idx], row) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Maps the lambda function across the entities in the ids list which match the specified filters. Ids that don't exist or don't match are skipped, and ids that are repeated are only visited once.
+// The ids are grouped by archetype first, so that each component storage is only looked up once per archetype rather than once per id. This means that the lambda won't be called in the same order as the ids list.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIds(ids []Id, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
+	gathered := v.filter.gather(v.world, ids)
+	defer v.filter.doneGathering(gathered)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while gathering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
+	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
+	var retJ *J
+	sparseJ := v.storageJ.sparse
+
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
+
+	var sliceL *componentList[L]
+	var compL []L
+	var retL *L
+	sparseL := v.storageL.sparse
+
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range gathered {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			sliceK, _ = v.storageK.slice.Get(archId)
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+			sliceL, _ = v.storageL.slice.Get(archId)
+			compL = nil
+			if sliceL != nil {
+				compL = sliceL.comp
+			}
+
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+			retK = nil
+			retL = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
+
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
+		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if compJ != nil {
+			retJ = &compJ[idx]
+		}
+		if compK != nil {
+			retK = &compK[idx]
+		}
+		if compL != nil {
+			retL = &compL[idx]
+		}
+		if sparse {
+
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+			if sparseJ != nil {
+				retJ = sparseJ.get(row.id)
+			}
+			if sparseK != nil {
+				retK = sparseK.get(row.id)
+			}
+			if sparseL != nil {
+				retL = sparseL.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+	}
+}
+
+// Maps the lambda function across every entity which matched the specified filters, in the specified order (eg. ById() or ByKey(...)).
+// The order is cached, so this is cheap if no entities have been added, removed or moved since the last call. ByKey orders are sorted again every call, because the keys could have changed.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdOrdered(order Order, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
+	rows, version := v.filter.orderedRows(v.world, order)
+	defer v.filter.doneOrdering(rows, order, version)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	sparse := v.sparse // Note: Sparse components are looked up by id, the filters were already checked while ordering
+
+	var sliceA *componentList[A]
+	var compA []A
+	var retA *A
+	sparseA := v.storageA.sparse
+
+	var sliceB *componentList[B]
+	var compB []B
+	var retB *B
+	sparseB := v.storageB.sparse
+
+	var sliceC *componentList[C]
+	var compC []C
+	var retC *C
+	sparseC := v.storageC.sparse
+
+	var sliceD *componentList[D]
+	var compD []D
+	var retD *D
+	sparseD := v.storageD.sparse
+
+	var sliceE *componentList[E]
+	var compE []E
+	var retE *E
+	sparseE := v.storageE.sparse
+
+	var sliceF *componentList[F]
+	var compF []F
+	var retF *F
+	sparseF := v.storageF.sparse
+
+	var sliceG *componentList[G]
+	var compG []G
+	var retG *G
+	sparseG := v.storageG.sparse
+
+	var sliceH *componentList[H]
+	var compH []H
+	var retH *H
+	sparseH := v.storageH.sparse
+
+	var sliceI *componentList[I]
+	var compI []I
 	var retI *I
+	sparseI := v.storageI.sparse
+
+	var sliceJ *componentList[J]
+	var compJ []J
 	var retJ *J
-	var retK *K
-	var retL *L
+	sparseJ := v.storageJ.sparse
 
-	sliceA, ok := v.storageA.slice.Get(loc.archId)
-	if ok {
-		retA = &sliceA.comp[index]
-	}
-	sliceB, ok := v.storageB.slice.Get(loc.archId)
-	if ok {
-		retB = &sliceB.comp[index]
-	}
-	sliceC, ok := v.storageC.slice.Get(loc.archId)
-	if ok {
-		retC = &sliceC.comp[index]
-	}
-	sliceD, ok := v.storageD.slice.Get(loc.archId)
-	if ok {
-		retD = &sliceD.comp[index]
-	}
-	sliceE, ok := v.storageE.slice.Get(loc.archId)
-	if ok {
-		retE = &sliceE.comp[index]
-	}
-	sliceF, ok := v.storageF.slice.Get(loc.archId)
-	if ok {
-		retF = &sliceF.comp[index]
-	}
-	sliceG, ok := v.storageG.slice.Get(loc.archId)
-	if ok {
-		retG = &sliceG.comp[index]
-	}
-	sliceH, ok := v.storageH.slice.Get(loc.archId)
-	if ok {
-		retH = &sliceH.comp[index]
-	}
-	sliceI, ok := v.storageI.slice.Get(loc.archId)
-	if ok {
-		retI = &sliceI.comp[index]
-	}
-	sliceJ, ok := v.storageJ.slice.Get(loc.archId)
-	if ok {
-		retJ = &sliceJ.comp[index]
-	}
-	sliceK, ok := v.storageK.slice.Get(loc.archId)
-	if ok {
-		retK = &sliceK.comp[index]
-	}
-	sliceL, ok := v.storageL.slice.Get(loc.archId)
-	if ok {
-		retL = &sliceL.comp[index]
-	}
+	var sliceK *componentList[K]
+	var compK []K
+	var retK *K
+	sparseK := v.storageK.sparse
 
-	return retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL
-}
+	var sliceL *componentList[L]
+	var compL []L
+	var retL *L
+	sparseL := v.storageL.sparse
+
+	// Note: Neighbouring rows are often in the same archetype, so the component slices are only looked up when the archetype changes
+	archId := archetypeId(0)
+	var lookup *lookupList
+	for i, row := range rows {
+		if i == 0 || row.loc.archId != archId {
+			archId = row.loc.archId
+			lookup = v.world.engine.lookup[archId]
+
+			sliceA, _ = v.storageA.slice.Get(archId)
+			compA = nil
+			if sliceA != nil {
+				compA = sliceA.comp
+			}
+			sliceB, _ = v.storageB.slice.Get(archId)
+			compB = nil
+			if sliceB != nil {
+				compB = sliceB.comp
+			}
+			sliceC, _ = v.storageC.slice.Get(archId)
+			compC = nil
+			if sliceC != nil {
+				compC = sliceC.comp
+			}
+			sliceD, _ = v.storageD.slice.Get(archId)
+			compD = nil
+			if sliceD != nil {
+				compD = sliceD.comp
+			}
+			sliceE, _ = v.storageE.slice.Get(archId)
+			compE = nil
+			if sliceE != nil {
+				compE = sliceE.comp
+			}
+			sliceF, _ = v.storageF.slice.Get(archId)
+			compF = nil
+			if sliceF != nil {
+				compF = sliceF.comp
+			}
+			sliceG, _ = v.storageG.slice.Get(archId)
+			compG = nil
+			if sliceG != nil {
+				compG = sliceG.comp
+			}
+			sliceH, _ = v.storageH.slice.Get(archId)
+			compH = nil
+			if sliceH != nil {
+				compH = sliceH.comp
+			}
+			sliceI, _ = v.storageI.slice.Get(archId)
+			compI = nil
+			if sliceI != nil {
+				compI = sliceI.comp
+			}
+			sliceJ, _ = v.storageJ.slice.Get(archId)
+			compJ = nil
+			if sliceJ != nil {
+				compJ = sliceJ.comp
+			}
+			sliceK, _ = v.storageK.slice.Get(archId)
+			compK = nil
+			if sliceK != nil {
+				compK = sliceK.comp
+			}
+			sliceL, _ = v.storageL.slice.Get(archId)
+			compL = nil
+			if sliceL != nil {
+				compL = sliceL.comp
+			}
 
-// Counts the number of entities that match this query
-func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) Count() int {
-	v.filter.regenerate(v.world)
+			retA = nil
+			retB = nil
+			retC = nil
+			retD = nil
+			retE = nil
+			retF = nil
+			retG = nil
+			retH = nil
+			retI = nil
+			retJ = nil
+			retK = nil
+			retL = nil
+		}
+
+		idx := row.loc.index
+		if int(idx) >= len(lookup.id) || lookup.id[idx] != row.id {
+			continue
+		} // Skip if the lambda moved or deleted the entity
 
-	total := 0
-	for _, archId := range v.filter.archIds {
-		lookup := v.world.engine.lookup[archId]
-		if lookup == nil {
-			panic("LookupList is missing!")
+		if compA != nil {
+			retA = &compA[idx]
+		}
+		if compB != nil {
+			retB = &compB[idx]
+		}
+		if compC != nil {
+			retC = &compC[idx]
 		}
+		if compD != nil {
+			retD = &compD[idx]
+		}
+		if compE != nil {
+			retE = &compE[idx]
+		}
+		if compF != nil {
+			retF = &compF[idx]
+		}
+		if compG != nil {
+			retG = &compG[idx]
+		}
+		if compH != nil {
+			retH = &compH[idx]
+		}
+		if compI != nil {
+			retI = &compI[idx]
+		}
+		if compJ != nil {
+			retJ = &compJ[idx]
+		}
+		if compK != nil {
+			retK = &compK[idx]
+		}
+		if compL != nil {
+			retL = &compL[idx]
+		}
+		if sparse {
 
-		total += lookup.Len()
+			if sparseA != nil {
+				retA = sparseA.get(row.id)
+			}
+			if sparseB != nil {
+				retB = sparseB.get(row.id)
+			}
+			if sparseC != nil {
+				retC = sparseC.get(row.id)
+			}
+			if sparseD != nil {
+				retD = sparseD.get(row.id)
+			}
+			if sparseE != nil {
+				retE = sparseE.get(row.id)
+			}
+			if sparseF != nil {
+				retF = sparseF.get(row.id)
+			}
+			if sparseG != nil {
+				retG = sparseG.get(row.id)
+			}
+			if sparseH != nil {
+				retH = sparseH.get(row.id)
+			}
+			if sparseI != nil {
+				retI = sparseI.get(row.id)
+			}
+			if sparseJ != nil {
+				retJ = sparseJ.get(row.id)
+			}
+			if sparseK != nil {
+				retK = sparseK.get(row.id)
+			}
+			if sparseL != nil {
+				retL = sparseL.get(row.id)
+			}
+		}
+		lambda(row.id, retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
 	}
-	return total
 }
 
-// Maps the lambda function across every entity which matched the specified filters.
-func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
+// Maps the lambda function across the entities which matched the specified filters, starting from where the cursor stopped last time.
+// Stops once the cursor's MaxEntities or Budget has been used up. Returns true if the end of the view was reached, in which case the cursor starts over at the beginning on the next call.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdCursor(cursor *QueryCursor, lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) bool {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+	cursor.begin()
 
 	var sliceA *componentList[A]
 	var compA []A
 	var retA *A
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
 	var compB []B
 	var retB *B
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
 	var compC []C
 	var retC *C
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
 	var compD []D
 	var retD *D
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
 	var compE []E
 	var retE *E
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
 	var compF []F
 	var retF *F
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
 	var compG []G
 	var retG *G
+	sparseG := v.storageG.sparse
 
 	var sliceH *componentList[H]
 	var compH []H
 	var retH *H
+	sparseH := v.storageH.sparse
 
 	var sliceI *componentList[I]
 	var compI []I
 	var retI *I
+	sparseI := v.storageI.sparse
 
 	var sliceJ *componentList[J]
 	var compJ []J
 	var retJ *J
+	sparseJ := v.storageJ.sparse
 
 	var sliceK *componentList[K]
 	var compK []K
 	var retK *K
+	sparseK := v.storageK.sparse
 
 	var sliceL *componentList[L]
 	var compL []L
 	var retL *L
+	sparseL := v.storageL.sparse
 
 	for _, archId := range v.filter.archIds {
+		// Note: archIds are sorted, so we can skip straight to the archetype that the cursor stopped in
+		if archId < cursor.archId {
+			continue
+		}
+		if archId > cursor.archId {
+			cursor.archId = archId
+			cursor.index = 0
+		}
 
 		sliceA, _ = v.storageA.slice.Get(archId)
 		sliceB, _ = v.storageB.slice.Get(archId)
@@ -5939,12 +16292,8 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a
 		if lookup == nil {
 			panic("LookupList is missing!")
 		}
-		// lookup, ok := v.world.engine.lookup[archId]
-		// if !ok { panic("LookupList is missing!") }
 		ids := lookup.id
 
-		// TODO - this flattened version causes a mild performance hit. But the other one combinatorially explodes. I also cant get BCE to work with it. See option 2 for higher performance.
-
 		compA = nil
 		if sliceA != nil {
 			compA = sliceA.comp
@@ -6006,79 +16355,182 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapId(lambda func(id Id, a
 		retJ = nil
 		retK = nil
 		retL = nil
-		for idx := range ids {
-			if ids[idx] == InvalidEntity {
-				continue
-			} // Skip if its a hole
-
-			if compA != nil {
-				retA = &compA[idx]
-			}
-			if compB != nil {
-				retB = &compB[idx]
-			}
-			if compC != nil {
-				retC = &compC[idx]
-			}
-			if compD != nil {
-				retD = &compD[idx]
-			}
-			if compE != nil {
-				retE = &compE[idx]
-			}
-			if compF != nil {
-				retF = &compF[idx]
-			}
-			if compG != nil {
-				retG = &compG[idx]
-			}
-			if compH != nil {
-				retH = &compH[idx]
-			}
-			if compI != nil {
-				retI = &compI[idx]
-			}
-			if compJ != nil {
-				retJ = &compJ[idx]
-			}
-			if compK != nil {
-				retK = &compK[idx]
+		if v.sparse {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+				if !v.filter.matchesSparse(v.world.engine, ids[idx]) {
+					continue
+				} // Skip if the sparse components dont match
+
+				if compA != nil {
+					retA = &compA[idx]
+				} else if sparseA != nil {
+					retA = sparseA.get(ids[idx])
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				} else if sparseB != nil {
+					retB = sparseB.get(ids[idx])
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				} else if sparseC != nil {
+					retC = sparseC.get(ids[idx])
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				} else if sparseD != nil {
+					retD = sparseD.get(ids[idx])
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				} else if sparseE != nil {
+					retE = sparseE.get(ids[idx])
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				} else if sparseF != nil {
+					retF = sparseF.get(ids[idx])
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				} else if sparseG != nil {
+					retG = sparseG.get(ids[idx])
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				} else if sparseH != nil {
+					retH = sparseH.get(ids[idx])
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				} else if sparseI != nil {
+					retI = sparseI.get(ids[idx])
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				} else if sparseJ != nil {
+					retJ = sparseJ.get(ids[idx])
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				} else if sparseK != nil {
+					retK = sparseK.get(ids[idx])
+				}
+				if compL != nil {
+					retL = &compL[idx]
+				} else if sparseL != nil {
+					retL = sparseL.get(ids[idx])
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+				cursor.visited++
 			}
-			if compL != nil {
-				retL = &compL[idx]
+		} else {
+			for idx := cursor.index; idx < len(ids); idx++ {
+				if cursor.exhausted() {
+					cursor.index = idx
+					cursor.pause(v.world.engine)
+					return false
+				}
+				if ids[idx] == InvalidEntity {
+					continue
+				} // Skip if its a hole
+
+				if compA != nil {
+					retA = &compA[idx]
+				}
+				if compB != nil {
+					retB = &compB[idx]
+				}
+				if compC != nil {
+					retC = &compC[idx]
+				}
+				if compD != nil {
+					retD = &compD[idx]
+				}
+				if compE != nil {
+					retE = &compE[idx]
+				}
+				if compF != nil {
+					retF = &compF[idx]
+				}
+				if compG != nil {
+					retG = &compG[idx]
+				}
+				if compH != nil {
+					retH = &compH[idx]
+				}
+				if compI != nil {
+					retI = &compI[idx]
+				}
+				if compJ != nil {
+					retJ = &compJ[idx]
+				}
+				if compK != nil {
+					retK = &compK[idx]
+				}
+				if compL != nil {
+					retL = &compL[idx]
+				}
+				lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+				cursor.visited++
 			}
-			lambda(ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
 		}
+		cursor.index = len(ids)
 	}
+
+	cursor.Reset()
+	return true
 }
 
 // Maps the lambda function across every entity which matched the specified filters. Components are split based on the number of OS threads available.
 func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdParallel(lambda func(id Id, a *A, b *B, c *C, d *D, e *E, f *F, g *G, h *H, i *I, j *J, k *K, l *L)) {
 	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
 
 	var sliceA *componentList[A]
+	sparseA := v.storageA.sparse
 
 	var sliceB *componentList[B]
+	sparseB := v.storageB.sparse
 
 	var sliceC *componentList[C]
+	sparseC := v.storageC.sparse
 
 	var sliceD *componentList[D]
+	sparseD := v.storageD.sparse
 
 	var sliceE *componentList[E]
+	sparseE := v.storageE.sparse
 
 	var sliceF *componentList[F]
+	sparseF := v.storageF.sparse
 
 	var sliceG *componentList[G]
+	sparseG := v.storageG.sparse
 
 	var sliceH *componentList[H]
+	sparseH := v.storageH.sparse
 
 	var sliceI *componentList[I]
+	sparseI := v.storageI.sparse
 
 	var sliceJ *componentList[J]
+	sparseJ := v.storageJ.sparse
 
 	var sliceK *componentList[K]
+	sparseK := v.storageK.sparse
 
 	var sliceL *componentList[L]
+	sparseL := v.storageL.sparse
 
 	// 1. Calculate work
 	// 2. Calculate number of threads to execute with
@@ -6163,6 +16615,80 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdParallel(lambda func(i
 				var retJ *J
 				var retK *K
 				var retL *L
+				if v.sparse {
+					for idx := range work.ids {
+						if work.ids[idx] == InvalidEntity {
+							continue
+						} // Skip if its a hole
+						if !v.filter.matchesSparse(v.world.engine, work.ids[idx]) {
+							continue
+						} // Skip if the sparse components dont match
+
+						if work.compA != nil {
+							retA = &work.compA[idx]
+						} else if sparseA != nil {
+							retA = sparseA.get(work.ids[idx])
+						}
+						if work.compB != nil {
+							retB = &work.compB[idx]
+						} else if sparseB != nil {
+							retB = sparseB.get(work.ids[idx])
+						}
+						if work.compC != nil {
+							retC = &work.compC[idx]
+						} else if sparseC != nil {
+							retC = sparseC.get(work.ids[idx])
+						}
+						if work.compD != nil {
+							retD = &work.compD[idx]
+						} else if sparseD != nil {
+							retD = sparseD.get(work.ids[idx])
+						}
+						if work.compE != nil {
+							retE = &work.compE[idx]
+						} else if sparseE != nil {
+							retE = sparseE.get(work.ids[idx])
+						}
+						if work.compF != nil {
+							retF = &work.compF[idx]
+						} else if sparseF != nil {
+							retF = sparseF.get(work.ids[idx])
+						}
+						if work.compG != nil {
+							retG = &work.compG[idx]
+						} else if sparseG != nil {
+							retG = sparseG.get(work.ids[idx])
+						}
+						if work.compH != nil {
+							retH = &work.compH[idx]
+						} else if sparseH != nil {
+							retH = sparseH.get(work.ids[idx])
+						}
+						if work.compI != nil {
+							retI = &work.compI[idx]
+						} else if sparseI != nil {
+							retI = sparseI.get(work.ids[idx])
+						}
+						if work.compJ != nil {
+							retJ = &work.compJ[idx]
+						} else if sparseJ != nil {
+							retJ = sparseJ.get(work.ids[idx])
+						}
+						if work.compK != nil {
+							retK = &work.compK[idx]
+						} else if sparseK != nil {
+							retK = sparseK.get(work.ids[idx])
+						}
+						if work.compL != nil {
+							retL = &work.compL[idx]
+						} else if sparseL != nil {
+							retL = sparseL.get(work.ids[idx])
+						}
+						lambda(work.ids[idx], retA, retB, retC, retD, retE, retF, retG, retH, retI, retJ, retK, retL)
+					}
+					continue
+				}
+
 				for idx := range work.ids {
 					if work.ids[idx] == InvalidEntity {
 						continue
@@ -6325,33 +16851,47 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdParallel(lambda func(i
 			}
 
 			// workPerformed += len(ids[start:end])
-			workChannel <- workItem{
+			item := workItem{
 				ids: ids[start:end],
+			}
 
-				compA: compA[start:end],
-
-				compB: compB[start:end],
-
-				compC: compC[start:end],
-
-				compD: compD[start:end],
-
-				compE: compE[start:end],
-
-				compF: compF[start:end],
-
-				compG: compG[start:end],
-
-				compH: compH[start:end],
-
-				compI: compI[start:end],
-
-				compJ: compJ[start:end],
-
-				compK: compK[start:end],
-
-				compL: compL[start:end],
+			if compA != nil {
+				item.compA = compA[start:end]
+			}
+			if compB != nil {
+				item.compB = compB[start:end]
+			}
+			if compC != nil {
+				item.compC = compC[start:end]
+			}
+			if compD != nil {
+				item.compD = compD[start:end]
+			}
+			if compE != nil {
+				item.compE = compE[start:end]
+			}
+			if compF != nil {
+				item.compF = compF[start:end]
+			}
+			if compG != nil {
+				item.compG = compG[start:end]
 			}
+			if compH != nil {
+				item.compH = compH[start:end]
+			}
+			if compI != nil {
+				item.compI = compI[start:end]
+			}
+			if compJ != nil {
+				item.compJ = compJ[start:end]
+			}
+			if compK != nil {
+				item.compK = compK[start:end]
+			}
+			if compL != nil {
+				item.compL = compL[start:end]
+			}
+			workChannel <- item
 		}
 
 		// if workPerformed != len(ids) {
@@ -6363,6 +16903,111 @@ func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapIdParallel(lambda func(i
 	waitGroup.Wait()
 }
 
+// Maps the lambda function across dense chunks of every entity which matched the specified filters.
+// Each archetype is split around its holes, so every chunk only contains live entities and the slices all have the same length as ids.
+// The slices point directly into the world's storage, so writing to them modifies the components. They are only valid for the duration of the lambda call:
+// don't keep them, don't append to them, and don't add, remove or delete any components while mapping (use a CommandQueue instead).
+// Optional components that an archetype doesn't have are passed as nil slices. Panics if any of the components are sparse.
+func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapChunks(lambda func(ids []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K, l []L)) {
+	if v.filter.hasSparse() {
+		panic("ecs: MapChunks doesn't support sparse components, use MapId instead")
+	}
+	v.filter.regenerate(v.world)
+	v.world.engine.beginIteration()
+	defer v.world.engine.endIteration()
+
+	var compA []A
+	var compB []B
+	var compC []C
+	var compD []D
+	var compE []E
+	var compF []F
+	var compG []G
+	var compH []H
+	var compI []I
+	var compJ []J
+	var compK []K
+	var compL []L
+
+	for _, archId := range v.filter.archIds {
+		lookup := v.world.engine.lookup[archId]
+		if lookup == nil {
+			panic("LookupList is missing!")
+		}
+		ids := lookup.id
+
+		compA = nil
+		if sliceA, ok := v.storageA.slice.Get(archId); ok {
+			compA = sliceA.comp
+		}
+		compB = nil
+		if sliceB, ok := v.storageB.slice.Get(archId); ok {
+			compB = sliceB.comp
+		}
+		compC = nil
+		if sliceC, ok := v.storageC.slice.Get(archId); ok {
+			compC = sliceC.comp
+		}
+		compD = nil
+		if sliceD, ok := v.storageD.slice.Get(archId); ok {
+			compD = sliceD.comp
+		}
+		compE = nil
+		if sliceE, ok := v.storageE.slice.Get(archId); ok {
+			compE = sliceE.comp
+		}
+		compF = nil
+		if sliceF, ok := v.storageF.slice.Get(archId); ok {
+			compF = sliceF.comp
+		}
+		compG = nil
+		if sliceG, ok := v.storageG.slice.Get(archId); ok {
+			compG = sliceG.comp
+		}
+		compH = nil
+		if sliceH, ok := v.storageH.slice.Get(archId); ok {
+			compH = sliceH.comp
+		}
+		compI = nil
+		if sliceI, ok := v.storageI.slice.Get(archId); ok {
+			compI = sliceI.comp
+		}
+		compJ = nil
+		if sliceJ, ok := v.storageJ.slice.Get(archId); ok {
+			compJ = sliceJ.comp
+		}
+		compK = nil
+		if sliceK, ok := v.storageK.slice.Get(archId); ok {
+			compK = sliceK.comp
+		}
+		compL = nil
+		if sliceL, ok := v.storageL.slice.Get(archId); ok {
+			compL = sliceL.comp
+		}
+
+		// Split the archetype into runs of rows that don't contain any holes
+		start := 0
+		for start < len(ids) {
+			if ids[start] == InvalidEntity {
+				start++
+				continue
+			}
+			end := start + 1
+			if len(lookup.holes) == 0 {
+				end = len(ids) // Fast path: The whole archetype is one chunk
+			}
+			for end < len(ids) && ids[end] != InvalidEntity {
+				end++
+			}
+
+			lambda(ids[start:end:end], chunkOf(compA, start, end), chunkOf(compB, start, end), chunkOf(compC, start, end), chunkOf(compD, start, end), chunkOf(compE, start, end), chunkOf(compF, start, end), chunkOf(compG, start, end), chunkOf(compH, start, end), chunkOf(compI, start, end), chunkOf(compJ, start, end), chunkOf(compK, start, end), chunkOf(compL, start, end))
+			start = end
+		}
+	}
+}
+
+// Note: Holes are included in the slices, prefer MapChunks which skips them.
+// Note: Sparse components aren't stored in slices, so archetypes are skipped if any of the components are sparse
 // Deprecated: This API is a tentative alternative way to map
 func (v *View12[A, B, C, D, E, F, G, H, I, J, K, L]) MapSlices(lambda func(id []Id, a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I, j []J, k []K, l []L)) {
 	v.filter.regenerate(v.world)
@@ -10,7 +10,7 @@ import (
 )
 
 var (
-	DefaultAllocation = 0
+	DefaultAllocation = 0 // The default initial capacity of each archetype, used by NewWorld and DefaultWorldOptions. Prefer setting WorldOptions.Allocation
 )
 
 const (
@@ -20,6 +20,7 @@ const (
 )
 
 // World is the main data-holder. You usually pass it to other functions to do things.
+// Every world has its own storage, but component and event ids are shared by all worlds in the process.
 type World struct {
 	idCounter    atomic.Uint64
 	nextId       Id
@@ -29,19 +30,41 @@ type World struct {
 	resources    map[reflect.Type]any
 	observers    *internalMap[EventId, list[Handler]] // TODO: SliceMap instead of map
 	cmd          *CommandQueue
+
+	compaction    CompactionPolicy
+	compactCursor int // The archetype that the next incremental compaction starts at
 }
 
-// Creates a new world
+// Creates a new world with the default options
 func NewWorld() *World {
+	return NewWorldWithOptions(DefaultWorldOptions())
+}
+
+// Creates a new world with the supplied options
+func NewWorldWithOptions(opts WorldOptions) *World {
 	world := &World{
 		nextId: firstEntity + 1,
 		minId:  firstEntity + 1,
 		maxId:  MaxEntity,
-		arch:   newLocMap(DefaultAllocation),
-		engine: newArchEngine(),
+		arch:   newLocMap(opts.Allocation),
+		engine: newArchEngine(opts.Allocation),
 
 		resources: make(map[reflect.Type]any),
 		observers: newMap[EventId, list[Handler]](0),
+
+		compaction: opts.Compaction,
+	}
+	world.engine.debug = opts.DebugChecks
+
+	if opts.MinId != 0 || opts.MaxId != 0 {
+		minId, maxId := opts.MinId, opts.MaxId
+		if minId == 0 {
+			minId = world.minId
+		}
+		if maxId == 0 {
+			maxId = world.maxId
+		}
+		world.SetIdRange(minId, maxId)
 	}
 
 	world.cmd = GetInjectable[*CommandQueue](world)
@@ -50,7 +73,7 @@ func NewWorld() *World {
 }
 
 func (w *World) print() {
-	fmt.Printf("%+v\n", *w)
+	fmt.Printf("%+v\n", w)
 
 	w.engine.print()
 }
@@ -118,6 +141,63 @@ func (world *World) spawn(id Id, comp ...Component) {
 	world.engine.runFinalizedHooks(id)
 }
 
+// Spawns n new entities which all start with the same component values, and returns their Ids.
+// This is much faster than calling Spawn in a loop, because the archetype is only looked up once, all of the rows are allocated together and each component column is filled in one pass. Add hooks are run for each entity after every entity has been written
+func SpawnBatch(world *World, n int, comp ...Component) []Id {
+	if n <= 0 {
+		return nil
+	}
+
+	ids := world.newIds(n)
+	// Note: Unlike buildArchMask, this keeps the sparse components so that their hooks are run too
+	var mask archetypeMask
+	for _, c := range comp {
+		mask.addComponent(c.CompId())
+	}
+	loc := world.allocateBatch(ids, mask)
+
+	wd := W{
+		engine: world.engine,
+		archId: loc.archId,
+	}
+	for j := range comp {
+		if batch, ok := comp[j].(batchWriter); ok {
+			batch.writeBatch(world.engine, loc.archId, int(loc.index), ids)
+			continue
+		}
+
+		// Note: Components that can't write in bulk are written one row at a time
+		for i, id := range ids {
+			wd.id = id
+			wd.index = int(loc.index) + i
+			comp[j].CompWrite(wd)
+		}
+	}
+
+	world.engine.runBatchHooks(ids, mask)
+	return ids
+}
+
+// Returns n new Ids
+func (w *World) newIds(n int) []Id {
+	ids := make([]Id, n)
+	for i := range ids {
+		ids[i] = w.NewId()
+	}
+	return ids
+}
+
+// Allocates a contiguous row for each of the new ids in the archetype of the mask. Sparse components in the mask are ignored.
+// Returns the location of the first row
+func (world *World) allocateBatch(ids []Id, mask archetypeMask) entLoc {
+	archId := world.engine.getArchetypeId(mask.withoutSparse())
+	start := world.engine.allocateBatch(archId, ids)
+	for i, id := range ids {
+		world.arch.Put(id, entLoc{archId, uint32(start + i)})
+	}
+	return entLoc{archId, uint32(start)}
+}
+
 // returns true if the entity in the world has the compId
 func (world *World) hasCompId(id Id, compId CompId) bool {
 	loc, ok := world.arch.Get(id)
@@ -125,6 +205,10 @@ func (world *World) hasCompId(id Id, compId CompId) bool {
 		return false
 	}
 
+	if isSparse(compId) {
+		return world.engine.hasSparse(compId, id)
+	}
+
 	lookup := world.engine.lookup[loc.archId]
 	return lookup.mask.hasComponent(compId)
 }
@@ -139,6 +223,9 @@ func (world *World) Write(id Id, comp ...Component) {
 	if len(comp) <= 0 {
 		return // Do nothing if there are no components
 	}
+	if world.engine.debug && id == InvalidEntity {
+		panic("ecs: tried to write components to InvalidEntity")
+	}
 
 	loc, ok := world.arch.Get(id)
 	if ok {
@@ -156,16 +243,17 @@ func (w *World) writeBundler(id Id, b *Bundler) {
 
 	wd := W{
 		engine: w.engine,
+		id:     id,
 		archId: newLoc.archId,
 		index:  int(newLoc.index),
 	}
 
-	for i := CompId(0); i <= b.maxComponentIdAdded; i++ {
-		if !b.Set[i] {
+	for _, comp := range b.Components {
+		if !b.archMask.hasComponent(comp.CompId()) {
 			continue
 		}
 
-		b.Components[i].CompWrite(wd)
+		comp.CompWrite(wd)
 	}
 
 	w.engine.runFinalizedHooks(id)
@@ -184,17 +272,17 @@ func (w *World) writeBundler(id Id, b *Bundler) {
 // Allocates an index for the id at the specified addMask location
 // 1. If the id already exists, an archetype move will happen
 // 2. If the id doesn't exist, then the addMask is the newMask and the entity will be allocated there
+// Sparse components in the addMask are ignored, if the id doesn't exist it will still be allocated in the empty archetype so they can be written.
 // Returns the index of the location allocated. May return -1 if invalid archMask supplied
 func (world *World) allocateMove(id Id, addMask archetypeMask) entLoc {
-	if addMask == blankArchMask {
-		// Nothing to allocate, aka do nothing
-		loc, _ := world.arch.Get(id)
-		// TODO: Technically this is some kind of error if id isn't set
-		return loc
-	}
+	addMask = addMask.withoutSparse()
 
 	loc, ok := world.arch.Get(id)
 	if ok {
+		if addMask == blankArchMask {
+			return loc // Nothing to allocate, aka do nothing
+		}
+
 		// Calculate the new mask based on the bitwise or of the old and added masks
 		lookup := world.engine.lookup[loc.archId]
 		oldMask := lookup.mask
@@ -216,6 +304,7 @@ func (world *World) allocateMove(id Id, addMask archetypeMask) entLoc {
 		archId := world.engine.getArchetypeId(addMask)
 		// Write all components to that archetype
 		newIndex := world.engine.allocate(archId, id)
+		world.engine.notifyEnter(id, archId)
 
 		newLoc := entLoc{archId, uint32(newIndex)}
 		world.arch.Put(id, newLoc)
@@ -239,7 +328,8 @@ func (world *World) deleteMask(id Id, deleteMask archetypeMask) {
 	newMask := oldMask.bitwiseClear(deleteMask)
 
 	// If the new mask requires the removal of all components, then just delete the current entity
-	if newMask == blankArchMask {
+	// Note: Entities that still have sparse components are kept in the empty archetype
+	if newMask == blankArchMask && !world.engine.hasAnySparse(id) {
 		Delete(world, id)
 		return
 	}
@@ -250,7 +340,7 @@ func (world *World) deleteMask(id Id, deleteMask archetypeMask) {
 	}
 
 	// 2. Move all components from source arch to dest arch
-	newLoc := world.engine.moveArchetypeDown(loc, newMask, id)
+	newLoc := world.engine.moveArchetype(loc, newMask, id)
 	world.arch.Put(id, newLoc)
 }
 
@@ -270,7 +360,10 @@ func Delete(world *World, id Id) bool {
 
 	world.arch.Delete(id)
 
+	world.engine.notifyExit(id, archId.archId)
 	world.engine.TagForDeletion(archId, id)
+	world.engine.deleteSparse(id)
+	world.engine.flushReactive()
 	return true
 }
 
@@ -283,8 +376,53 @@ func DeleteComponent(world *World, id Id, comp ...Component) {
 		return
 	}
 
+	if !world.arch.Has(id) {
+		return
+	}
+
+	// Sparse components can be removed in place
+	for _, c := range comp {
+		if isSparse(c.CompId()) {
+			if world.engine.getStorage(c.CompId()).(sparseStorage).removeSparse(id) {
+				world.engine.layoutVersion++
+			}
+		}
+	}
+
 	mask := buildArchMask(comp...)
 	world.deleteMask(id, mask)
+	world.engine.flushReactive()
+}
+
+// Pre-allocates room for n more entities in the archetype of the supplied components, so that spawning them doesn't need to grow any slices.
+// Only the types of the components are used, so you can pass zero values. Sparse components are ignored
+func (w *World) Reserve(n int, comp ...Component) {
+	if n <= 0 {
+		return
+	}
+
+	mask := buildArchMask(comp...)
+	archId := w.engine.getArchetypeId(mask)
+	w.engine.reserve(archId, n)
+}
+
+// Removes every archetype that doesn't contain any entities, so that their memory is released and their ids can be reused.
+// This is useful if you create lots of short lived archetypes, because otherwise archetypes live forever. Returns the number of archetypes removed.
+// This must not be called while iterating a view
+func (w *World) CollectEmptyArchetypes() int {
+	w.engine.checkNotIterating("CollectEmptyArchetypes")
+	return w.engine.collectEmptyArchetypes()
+}
+
+// Removes every entity from the world, but keeps the archetypes and their allocated memory so that the world can be reused (eg. between rounds of a match).
+// Resources, observers, hooks and the compaction policy are kept, and any pending commands are dropped.
+// The Id counter isn't reset, so old Ids won't refer to new entities. This must not be called while iterating a view
+func (w *World) Reset() {
+	w.engine.checkNotIterating("Reset")
+	w.arch.Clear()
+	w.engine.reset()
+	w.cmd.clear()
+	w.engine.flushReactive()
 }
 
 // Returns true if the entity exists in the world else it returns false
@@ -362,3 +362,255 @@ func TestResources(t *testing.T) {
 	compare(t, p1, &p) // Should match the original pointer
 	compare(t, *p1, p)
 }
+
+func TestCollectEmptyArchetypes(t *testing.T) {
+	world := NewWorld()
+	keep := world.Spawn(C(position{1, 1, 1}))
+	temp := world.Spawn(C(position{}), C(velocity{}), C(enemy{}))
+
+	query := Query1[position](world)
+	compare(t, query.Count(), 2)
+
+	Delete(world, temp)
+	numArchetypes := len(world.engine.lookup)
+	compare(t, world.CollectEmptyArchetypes(), 1)
+	compare(t, world.CollectEmptyArchetypes(), 0)
+	compare(t, query.Count(), 1)
+
+	// The removed archetype gets reused for the next new mask
+	id := world.Spawn(C(velocity{3, 3, 3}), C(enemy{}))
+	compare(t, len(world.engine.lookup), numArchetypes)
+	v, ok := Read[velocity](world, id)
+	check(t, ok)
+	compare(t, v, velocity{3, 3, 3})
+	_, ok = Read[enemy](world, id)
+	check(t, ok)
+	_, ok = Read[position](world, id)
+	check(t, !ok)
+	compare(t, query.Count(), 1)
+	compare(t, Query1[velocity](world).Count(), 1)
+
+	// Edges are rebuilt after archetypes are removed
+	world.Write(keep, C(velocity{}))
+	Delete(world, id)
+	world.CollectEmptyArchetypes()
+	DeleteComponent(world, keep, C(velocity{}))
+	world.Write(keep, C(velocity{4, 4, 4}))
+	p, _ := Read[position](world, keep)
+	compare(t, p, position{1, 1, 1})
+	v, _ = Read[velocity](world, keep)
+	compare(t, v, velocity{4, 4, 4})
+	compare(t, Query2[position, velocity](world).Count(), 1)
+}
+
+func TestWorldReset(t *testing.T) {
+	world := NewWorld()
+	ids := make([]Id, 0)
+	for i := 0; i < 100; i++ {
+		ids = append(ids, world.Spawn(C(position{}), C(velocity{})))
+	}
+	world.Spawn(C(stunned{}))
+	world.Cmd().SpawnEmpty().Insert(C(position{}))
+
+	loc, _ := world.arch.Get(ids[0])
+	lookup := world.engine.lookup[loc.archId]
+	capacity := cap(lookup.id)
+	numArchetypes := len(world.engine.lookup)
+
+	world.Reset()
+	check(t, !world.Exists(ids[0]))
+	compare(t, Query1[position](world).Count(), 0)
+	compare(t, Query1[stunned](world).Count(), 0)
+	compare(t, cap(lookup.id), capacity)
+	compare(t, len(world.engine.lookup), numArchetypes)
+
+	world.Cmd().Execute() // Pending commands were dropped
+	compare(t, Query1[position](world).Count(), 0)
+
+	// New entities don't reuse old Ids
+	id := world.Spawn(C(position{1, 2, 3}), C(velocity{}))
+	check(t, id != ids[0])
+	p, ok := Read[position](world, id)
+	check(t, ok)
+	compare(t, p, position{1, 2, 3})
+	compare(t, Query2[position, velocity](world).Count(), 1)
+}
+
+func TestNewWorldWithOptions(t *testing.T) {
+	small := NewWorld()
+	big := NewWorldWithOptions(WorldOptions{
+		Allocation: 1000,
+		MinId:      5000,
+		MaxId:      6000,
+		Compaction: CompactionPolicy{HoleRatio: 0.5},
+	})
+
+	id := big.Spawn(C(position{}))
+	check(t, id >= 5000 && id < 6000)
+	loc, _ := big.arch.Get(id)
+	compare(t, cap(big.engine.lookup[loc.archId].id), 1000)
+	compare(t, cap(getStorage[position](big.engine).GetSlice(loc.archId).comp), 1000)
+
+	// The other world keeps its own configuration
+	id = small.Spawn(C(position{}))
+	check(t, id < 5000)
+	loc, _ = small.arch.Get(id)
+	check(t, cap(small.engine.lookup[loc.archId].id) < 1000)
+
+	// Compaction policy is applied
+	first := big.Spawn(C(position{}))
+	loc, _ = big.arch.Get(first)
+	lookup := big.engine.lookup[loc.archId]
+	Delete(big, first)
+	compare(t, len(lookup.holes), 1)
+	big.Cmd().Execute()
+	compare(t, len(lookup.holes), 0)
+}
+
+func TestDebugChecks(t *testing.T) {
+	world := NewWorldWithOptions(WorldOptions{DebugChecks: true})
+	world.Spawn(C(position{}))
+
+	expectPanic := func(f func()) {
+		defer func() {
+			check(t, recover() != nil)
+		}()
+		f()
+	}
+
+	query := Query1[position](world)
+	expectPanic(func() {
+		query.MapId(func(id Id, p *position) {
+			world.CleanupHoles()
+		})
+	})
+	compare(t, world.engine.iterating, 0) // Iteration tracking unwinds after a panic
+
+	expectPanic(func() {
+		world.Write(InvalidEntity, C(position{}))
+	})
+
+	// Outside of a map everything is fine
+	world.CleanupHoles()
+	world.Reset()
+}
+
+func TestWorldReserve(t *testing.T) {
+	world := NewWorld()
+	world.Reserve(1000, C(position{}), C(velocity{}), C(enemy{}))
+
+	id := world.Spawn(C(position{}), C(velocity{}), C(enemy{}))
+	loc, _ := world.arch.Get(id)
+	lookup := world.engine.lookup[loc.archId]
+	positions := getStorage[position](world.engine).GetSlice(loc.archId)
+	check(t, cap(lookup.id) >= 1000)
+	check(t, cap(positions.comp) >= 1000)
+
+	// Spawning up to the reservation doesn't grow anything
+	idCap, posCap := cap(lookup.id), cap(positions.comp)
+	for i := 1; i < 1000; i++ {
+		world.Spawn(C(position{}), C(velocity{}), C(enemy{}))
+	}
+	compare(t, cap(lookup.id), idCap)
+	compare(t, cap(positions.comp), posCap)
+	compare(t, Query2[position, velocity](world).Count(), 1000)
+
+}
+
+func TestWorldReserveWithHoles(t *testing.T) {
+	world := NewWorld()
+	ids := SpawnBatch(world, 100, C(position{}), C(velocity{}))
+	for _, id := range ids[:50] {
+		Delete(world, id)
+	}
+
+	// Batches append after the holes, so the reservation still has to cover all of them
+	world.Reserve(500, C(position{}), C(velocity{}))
+	loc, _ := world.arch.Get(ids[99])
+	lookup := world.engine.lookup[loc.archId]
+	positions := getStorage[position](world.engine).GetSlice(loc.archId)
+	idCap, posCap := cap(lookup.id), cap(positions.comp)
+
+	SpawnBatch(world, 500, C(position{}), C(velocity{}))
+	compare(t, cap(lookup.id), idCap)
+	compare(t, cap(positions.comp), posCap)
+	compare(t, Query2[position, velocity](world).Count(), 550)
+}
+
+func TestSpawnBatch(t *testing.T) {
+	world := NewWorld()
+	world.Spawn(C(position{}), C(velocity{}))
+	Delete(world, world.Spawn(C(position{}), C(velocity{}))) // Leave a hole behind
+
+	added := 0
+	world.SetHookOnAdd(C(stunned{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		added++
+	}))
+	world.SetHookOnAdd(C(velocity{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		added++
+	}))
+
+	ids := SpawnBatch(world, 100, C(position{1, 2, 3}), C(velocity{4, 5, 6}), C(stunned{7}))
+	compare(t, len(ids), 100)
+	compare(t, added, 200)
+	compare(t, Query2[position, velocity](world).Count(), 101)
+
+	for _, id := range ids {
+		p, ok := Read[position](world, id)
+		check(t, ok)
+		compare(t, p, position{1, 2, 3})
+		s, ok := Read[stunned](world, id)
+		check(t, ok)
+		compare(t, s, stunned{7})
+	}
+
+	check(t, SpawnBatch(world, 0, C(position{})) == nil)
+}
+
+func TestSpawnBatchColumns(t *testing.T) {
+	world := NewWorld()
+	world.Spawn(C(position{}), C(radius{}), dynRaw.With([]byte{9, 9, 9}), dynHealth)
+
+	// Boxed and dynamic components are written a column at a time, other components fall back to writing each row
+	ids := SpawnBatch(world, 50, C(position{1, 2, 3}), radius{4}, dynRaw.With([]byte{1, 2, 3}), dynHealth)
+	for _, id := range ids {
+		p, _ := Read[position](world, id)
+		compare(t, p, position{1, 2, 3})
+		r, _ := Read[radius](world, id)
+		compare(t, r, radius{4})
+		raw, ok := ReadDynamic(world, id, dynRaw)
+		check(t, ok)
+		compare(t, string(raw), string([]byte{1, 2, 3}))
+		health, ok := ReadDynamic(world, id, dynHealth)
+		check(t, ok)
+		compare(t, string(health), string(make([]byte, 8)))
+	}
+}
+
+func TestBundleSpawnBatch(t *testing.T) {
+	world := NewWorld()
+	bundle := NewBundle3[position, velocity, enemy]()
+
+	added := 0
+	world.SetHookOnAdd(C(enemy{}), NewHandler(func(trigger Trigger[OnAdd]) {
+		added++
+	}))
+
+	ids := bundle.SpawnBatch(world, 50, position{1, 1, 1}, velocity{2, 2, 2}, enemy{})
+	compare(t, len(ids), 50)
+	compare(t, added, 50)
+
+	found := 0
+	Query3[position, velocity, enemy](world).MapId(func(id Id, p *position, v *velocity, e *enemy) {
+		compare(t, id, ids[found])
+		compare(t, *p, position{1, 1, 1})
+		compare(t, *v, velocity{2, 2, 2})
+		found++
+	})
+	compare(t, found, 50)
+
+	// The batch can be moved and deleted like any other entity
+	DeleteComponent(world, ids[0], C(velocity{}))
+	check(t, Delete(world, ids[1]))
+	compare(t, Query2[position, velocity](world).Count(), 48)
+}